@@ -0,0 +1,5248 @@
+// +build arm64,!generic
+
+#include "textflag.h"
+
+
+// c =  (a + b) % q
+// func add(c *[12]uint64, a *[12]uint64, b *[12]uint64)
+TEXT ·add(SB), NOSPLIT, $0-24
+	MOVD a+8(FP), R25
+	LDP 0(R25), (R0, R1)
+	LDP 16(R25), (R2, R3)
+	LDP 32(R25), (R4, R5)
+	LDP 48(R25), (R6, R7)
+	LDP 64(R25), (R8, R9)
+	LDP 80(R25), (R10, R11)
+	MOVD b+16(FP), R25
+	LDP 0(R25), (R12, R13)
+	LDP 16(R25), (R14, R15)
+	LDP 32(R25), (R16, R17)
+	LDP 48(R25), (R19, R20)
+	LDP 64(R25), (R21, R22)
+	LDP 80(R25), (R23, R24)
+
+	ADDS R12, R0, R0
+	ADCS R13, R1, R1
+	ADCS R14, R2, R2
+	ADCS R15, R3, R3
+	ADCS R16, R4, R4
+	ADCS R17, R5, R5
+	ADCS R19, R6, R6
+	ADCS R20, R7, R7
+	ADCS R21, R8, R8
+	ADCS R22, R9, R9
+	ADCS R23, R10, R10
+	ADCS R24, R11, R11
+	ADC ZR, ZR, R25
+
+	// | t - q
+	MOVD ·modulus+0(SB), R12
+	MOVD ·modulus+8(SB), R13
+	MOVD ·modulus+16(SB), R14
+	MOVD ·modulus+24(SB), R15
+	MOVD ·modulus+32(SB), R16
+	MOVD ·modulus+40(SB), R17
+	MOVD ·modulus+48(SB), R19
+	MOVD ·modulus+56(SB), R20
+	MOVD ·modulus+64(SB), R21
+	MOVD ·modulus+72(SB), R22
+	MOVD ·modulus+80(SB), R23
+	MOVD ·modulus+88(SB), R24
+	SUBS R12, R0, R12
+	SBCS R13, R1, R13
+	SBCS R14, R2, R14
+	SBCS R15, R3, R15
+	SBCS R16, R4, R16
+	SBCS R17, R5, R17
+	SBCS R19, R6, R19
+	SBCS R20, R7, R20
+	SBCS R21, R8, R21
+	SBCS R22, R9, R22
+	SBCS R23, R10, R23
+	SBCS R24, R11, R24
+	SBCS ZR, R25, ZR
+
+	// | select
+	CSEL CS, R12, R0, R0
+	CSEL CS, R13, R1, R1
+	CSEL CS, R14, R2, R2
+	CSEL CS, R15, R3, R3
+	CSEL CS, R16, R4, R4
+	CSEL CS, R17, R5, R5
+	CSEL CS, R19, R6, R6
+	CSEL CS, R20, R7, R7
+	CSEL CS, R21, R8, R8
+	CSEL CS, R22, R9, R9
+	CSEL CS, R23, R10, R10
+	CSEL CS, R24, R11, R11
+
+	MOVD c+0(FP), R25
+	STP (R0, R1), 0(R25)
+	STP (R2, R3), 16(R25)
+	STP (R4, R5), 32(R25)
+	STP (R6, R7), 48(R25)
+	STP (R8, R9), 64(R25)
+	STP (R10, R11), 80(R25)
+	RET
+/*	 | end													*/
+
+
+// a =  (a + b) % q
+// func addAssign(a *[12]uint64, b *[12]uint64)
+TEXT ·addAssign(SB), NOSPLIT, $0-16
+	MOVD a+0(FP), R25
+	LDP 0(R25), (R0, R1)
+	LDP 16(R25), (R2, R3)
+	LDP 32(R25), (R4, R5)
+	LDP 48(R25), (R6, R7)
+	LDP 64(R25), (R8, R9)
+	LDP 80(R25), (R10, R11)
+	MOVD b+8(FP), R25
+	LDP 0(R25), (R12, R13)
+	LDP 16(R25), (R14, R15)
+	LDP 32(R25), (R16, R17)
+	LDP 48(R25), (R19, R20)
+	LDP 64(R25), (R21, R22)
+	LDP 80(R25), (R23, R24)
+
+	ADDS R12, R0, R0
+	ADCS R13, R1, R1
+	ADCS R14, R2, R2
+	ADCS R15, R3, R3
+	ADCS R16, R4, R4
+	ADCS R17, R5, R5
+	ADCS R19, R6, R6
+	ADCS R20, R7, R7
+	ADCS R21, R8, R8
+	ADCS R22, R9, R9
+	ADCS R23, R10, R10
+	ADCS R24, R11, R11
+	ADC ZR, ZR, R25
+
+	// | t - q
+	MOVD ·modulus+0(SB), R12
+	MOVD ·modulus+8(SB), R13
+	MOVD ·modulus+16(SB), R14
+	MOVD ·modulus+24(SB), R15
+	MOVD ·modulus+32(SB), R16
+	MOVD ·modulus+40(SB), R17
+	MOVD ·modulus+48(SB), R19
+	MOVD ·modulus+56(SB), R20
+	MOVD ·modulus+64(SB), R21
+	MOVD ·modulus+72(SB), R22
+	MOVD ·modulus+80(SB), R23
+	MOVD ·modulus+88(SB), R24
+	SUBS R12, R0, R12
+	SBCS R13, R1, R13
+	SBCS R14, R2, R14
+	SBCS R15, R3, R15
+	SBCS R16, R4, R16
+	SBCS R17, R5, R17
+	SBCS R19, R6, R19
+	SBCS R20, R7, R20
+	SBCS R21, R8, R21
+	SBCS R22, R9, R22
+	SBCS R23, R10, R23
+	SBCS R24, R11, R24
+	SBCS ZR, R25, ZR
+
+	// | select
+	CSEL CS, R12, R0, R0
+	CSEL CS, R13, R1, R1
+	CSEL CS, R14, R2, R2
+	CSEL CS, R15, R3, R3
+	CSEL CS, R16, R4, R4
+	CSEL CS, R17, R5, R5
+	CSEL CS, R19, R6, R6
+	CSEL CS, R20, R7, R7
+	CSEL CS, R21, R8, R8
+	CSEL CS, R22, R9, R9
+	CSEL CS, R23, R10, R10
+	CSEL CS, R24, R11, R11
+
+	MOVD a+0(FP), R25
+	STP (R0, R1), 0(R25)
+	STP (R2, R3), 16(R25)
+	STP (R4, R5), 32(R25)
+	STP (R6, R7), 48(R25)
+	STP (R8, R9), 64(R25)
+	STP (R10, R11), 80(R25)
+	RET
+/*	 | end													*/
+
+
+// lazy addition
+// c = (a + b)
+// func ladd(c *[12]uint64, a *[12]uint64, b *[12]uint64)
+TEXT ·ladd(SB), NOSPLIT, $0-24
+	MOVD a+8(FP), R25
+	LDP 0(R25), (R0, R1)
+	LDP 16(R25), (R2, R3)
+	LDP 32(R25), (R4, R5)
+	LDP 48(R25), (R6, R7)
+	LDP 64(R25), (R8, R9)
+	LDP 80(R25), (R10, R11)
+	MOVD b+16(FP), R25
+	LDP 0(R25), (R12, R13)
+	LDP 16(R25), (R14, R15)
+	LDP 32(R25), (R16, R17)
+	LDP 48(R25), (R19, R20)
+	LDP 64(R25), (R21, R22)
+	LDP 80(R25), (R23, R24)
+
+	ADDS R12, R0, R0
+	ADCS R13, R1, R1
+	ADCS R14, R2, R2
+	ADCS R15, R3, R3
+	ADCS R16, R4, R4
+	ADCS R17, R5, R5
+	ADCS R19, R6, R6
+	ADCS R20, R7, R7
+	ADCS R21, R8, R8
+	ADCS R22, R9, R9
+	ADCS R23, R10, R10
+	ADCS R24, R11, R11
+
+	MOVD c+0(FP), R25
+	STP (R0, R1), 0(R25)
+	STP (R2, R3), 16(R25)
+	STP (R4, R5), 32(R25)
+	STP (R6, R7), 48(R25)
+	STP (R8, R9), 64(R25)
+	STP (R10, R11), 80(R25)
+	RET
+/*	 | end													*/
+
+
+// lazy addition
+// a = (a + b)
+// func laddAssign(a *[12]uint64, b *[12]uint64)
+TEXT ·laddAssign(SB), NOSPLIT, $0-16
+	MOVD a+0(FP), R25
+	LDP 0(R25), (R0, R1)
+	LDP 16(R25), (R2, R3)
+	LDP 32(R25), (R4, R5)
+	LDP 48(R25), (R6, R7)
+	LDP 64(R25), (R8, R9)
+	LDP 80(R25), (R10, R11)
+	MOVD b+8(FP), R25
+	LDP 0(R25), (R12, R13)
+	LDP 16(R25), (R14, R15)
+	LDP 32(R25), (R16, R17)
+	LDP 48(R25), (R19, R20)
+	LDP 64(R25), (R21, R22)
+	LDP 80(R25), (R23, R24)
+
+	ADDS R12, R0, R0
+	ADCS R13, R1, R1
+	ADCS R14, R2, R2
+	ADCS R15, R3, R3
+	ADCS R16, R4, R4
+	ADCS R17, R5, R5
+	ADCS R19, R6, R6
+	ADCS R20, R7, R7
+	ADCS R21, R8, R8
+	ADCS R22, R9, R9
+	ADCS R23, R10, R10
+	ADCS R24, R11, R11
+
+	MOVD a+0(FP), R25
+	STP (R0, R1), 0(R25)
+	STP (R2, R3), 16(R25)
+	STP (R4, R5), 32(R25)
+	STP (R6, R7), 48(R25)
+	STP (R8, R9), 64(R25)
+	STP (R10, R11), 80(R25)
+	RET
+/*	 | end													*/
+
+
+// c =  (2 * a) % q
+// func double(c *[12]uint64, a *[12]uint64)
+TEXT ·double(SB), NOSPLIT, $0-16
+	MOVD a+8(FP), R25
+	LDP 0(R25), (R0, R1)
+	LDP 16(R25), (R2, R3)
+	LDP 32(R25), (R4, R5)
+	LDP 48(R25), (R6, R7)
+	LDP 64(R25), (R8, R9)
+	LDP 80(R25), (R10, R11)
+
+	ADDS R0, R0, R0
+	ADCS R1, R1, R1
+	ADCS R2, R2, R2
+	ADCS R3, R3, R3
+	ADCS R4, R4, R4
+	ADCS R5, R5, R5
+	ADCS R6, R6, R6
+	ADCS R7, R7, R7
+	ADCS R8, R8, R8
+	ADCS R9, R9, R9
+	ADCS R10, R10, R10
+	ADCS R11, R11, R11
+	ADC ZR, ZR, R25
+
+	// | t - q
+	MOVD ·modulus+0(SB), R12
+	MOVD ·modulus+8(SB), R13
+	MOVD ·modulus+16(SB), R14
+	MOVD ·modulus+24(SB), R15
+	MOVD ·modulus+32(SB), R16
+	MOVD ·modulus+40(SB), R17
+	MOVD ·modulus+48(SB), R19
+	MOVD ·modulus+56(SB), R20
+	MOVD ·modulus+64(SB), R21
+	MOVD ·modulus+72(SB), R22
+	MOVD ·modulus+80(SB), R23
+	MOVD ·modulus+88(SB), R24
+	SUBS R12, R0, R12
+	SBCS R13, R1, R13
+	SBCS R14, R2, R14
+	SBCS R15, R3, R15
+	SBCS R16, R4, R16
+	SBCS R17, R5, R17
+	SBCS R19, R6, R19
+	SBCS R20, R7, R20
+	SBCS R21, R8, R21
+	SBCS R22, R9, R22
+	SBCS R23, R10, R23
+	SBCS R24, R11, R24
+	SBCS ZR, R25, ZR
+
+	// | select
+	CSEL CS, R12, R0, R0
+	CSEL CS, R13, R1, R1
+	CSEL CS, R14, R2, R2
+	CSEL CS, R15, R3, R3
+	CSEL CS, R16, R4, R4
+	CSEL CS, R17, R5, R5
+	CSEL CS, R19, R6, R6
+	CSEL CS, R20, R7, R7
+	CSEL CS, R21, R8, R8
+	CSEL CS, R22, R9, R9
+	CSEL CS, R23, R10, R10
+	CSEL CS, R24, R11, R11
+
+	MOVD c+0(FP), R25
+	STP (R0, R1), 0(R25)
+	STP (R2, R3), 16(R25)
+	STP (R4, R5), 32(R25)
+	STP (R6, R7), 48(R25)
+	STP (R8, R9), 64(R25)
+	STP (R10, R11), 80(R25)
+	RET
+/*	 | end													*/
+
+
+// a =  (2 * a) % q
+// func doubleAssign(a *[12]uint64)
+TEXT ·doubleAssign(SB), NOSPLIT, $0-8
+	MOVD a+0(FP), R25
+	LDP 0(R25), (R0, R1)
+	LDP 16(R25), (R2, R3)
+	LDP 32(R25), (R4, R5)
+	LDP 48(R25), (R6, R7)
+	LDP 64(R25), (R8, R9)
+	LDP 80(R25), (R10, R11)
+
+	ADDS R0, R0, R0
+	ADCS R1, R1, R1
+	ADCS R2, R2, R2
+	ADCS R3, R3, R3
+	ADCS R4, R4, R4
+	ADCS R5, R5, R5
+	ADCS R6, R6, R6
+	ADCS R7, R7, R7
+	ADCS R8, R8, R8
+	ADCS R9, R9, R9
+	ADCS R10, R10, R10
+	ADCS R11, R11, R11
+	ADC ZR, ZR, R25
+
+	// | t - q
+	MOVD ·modulus+0(SB), R12
+	MOVD ·modulus+8(SB), R13
+	MOVD ·modulus+16(SB), R14
+	MOVD ·modulus+24(SB), R15
+	MOVD ·modulus+32(SB), R16
+	MOVD ·modulus+40(SB), R17
+	MOVD ·modulus+48(SB), R19
+	MOVD ·modulus+56(SB), R20
+	MOVD ·modulus+64(SB), R21
+	MOVD ·modulus+72(SB), R22
+	MOVD ·modulus+80(SB), R23
+	MOVD ·modulus+88(SB), R24
+	SUBS R12, R0, R12
+	SBCS R13, R1, R13
+	SBCS R14, R2, R14
+	SBCS R15, R3, R15
+	SBCS R16, R4, R16
+	SBCS R17, R5, R17
+	SBCS R19, R6, R19
+	SBCS R20, R7, R20
+	SBCS R21, R8, R21
+	SBCS R22, R9, R22
+	SBCS R23, R10, R23
+	SBCS R24, R11, R24
+	SBCS ZR, R25, ZR
+
+	// | select
+	CSEL CS, R12, R0, R0
+	CSEL CS, R13, R1, R1
+	CSEL CS, R14, R2, R2
+	CSEL CS, R15, R3, R3
+	CSEL CS, R16, R4, R4
+	CSEL CS, R17, R5, R5
+	CSEL CS, R19, R6, R6
+	CSEL CS, R20, R7, R7
+	CSEL CS, R21, R8, R8
+	CSEL CS, R22, R9, R9
+	CSEL CS, R23, R10, R10
+	CSEL CS, R24, R11, R11
+
+	MOVD a+0(FP), R25
+	STP (R0, R1), 0(R25)
+	STP (R2, R3), 16(R25)
+	STP (R4, R5), 32(R25)
+	STP (R6, R7), 48(R25)
+	STP (R8, R9), 64(R25)
+	STP (R10, R11), 80(R25)
+	RET
+/*	 | end													*/
+
+
+// lazy doubling
+// c = (2 * a)
+// func ldouble(c *[12]uint64, a *[12]uint64)
+TEXT ·ldouble(SB), NOSPLIT, $0-16
+	MOVD a+8(FP), R25
+	LDP 0(R25), (R0, R1)
+	LDP 16(R25), (R2, R3)
+	LDP 32(R25), (R4, R5)
+	LDP 48(R25), (R6, R7)
+	LDP 64(R25), (R8, R9)
+	LDP 80(R25), (R10, R11)
+
+	ADDS R0, R0, R0
+	ADCS R1, R1, R1
+	ADCS R2, R2, R2
+	ADCS R3, R3, R3
+	ADCS R4, R4, R4
+	ADCS R5, R5, R5
+	ADCS R6, R6, R6
+	ADCS R7, R7, R7
+	ADCS R8, R8, R8
+	ADCS R9, R9, R9
+	ADCS R10, R10, R10
+	ADCS R11, R11, R11
+
+	MOVD c+0(FP), R25
+	STP (R0, R1), 0(R25)
+	STP (R2, R3), 16(R25)
+	STP (R4, R5), 32(R25)
+	STP (R6, R7), 48(R25)
+	STP (R8, R9), 64(R25)
+	STP (R10, R11), 80(R25)
+	RET
+/*	 | end													*/
+
+
+// lazy doubling
+// a = (2 * a)
+// func ldoubleAssign(a *[12]uint64)
+TEXT ·ldoubleAssign(SB), NOSPLIT, $0-8
+	MOVD a+0(FP), R25
+	LDP 0(R25), (R0, R1)
+	LDP 16(R25), (R2, R3)
+	LDP 32(R25), (R4, R5)
+	LDP 48(R25), (R6, R7)
+	LDP 64(R25), (R8, R9)
+	LDP 80(R25), (R10, R11)
+
+	ADDS R0, R0, R0
+	ADCS R1, R1, R1
+	ADCS R2, R2, R2
+	ADCS R3, R3, R3
+	ADCS R4, R4, R4
+	ADCS R5, R5, R5
+	ADCS R6, R6, R6
+	ADCS R7, R7, R7
+	ADCS R8, R8, R8
+	ADCS R9, R9, R9
+	ADCS R10, R10, R10
+	ADCS R11, R11, R11
+
+	MOVD a+0(FP), R25
+	STP (R0, R1), 0(R25)
+	STP (R2, R3), 16(R25)
+	STP (R4, R5), 32(R25)
+	STP (R6, R7), 48(R25)
+	STP (R8, R9), 64(R25)
+	STP (R10, R11), 80(R25)
+	RET
+/*	 | end													*/
+
+
+// c =  (a - b) % q
+// func sub(c *[12]uint64, a *[12]uint64, b *[12]uint64)
+TEXT ·sub(SB), NOSPLIT, $0-24
+	MOVD a+8(FP), R25
+	LDP 0(R25), (R0, R1)
+	LDP 16(R25), (R2, R3)
+	LDP 32(R25), (R4, R5)
+	LDP 48(R25), (R6, R7)
+	LDP 64(R25), (R8, R9)
+	LDP 80(R25), (R10, R11)
+	MOVD b+16(FP), R25
+	LDP 0(R25), (R12, R13)
+	LDP 16(R25), (R14, R15)
+	LDP 32(R25), (R16, R17)
+	LDP 48(R25), (R19, R20)
+	LDP 64(R25), (R21, R22)
+	LDP 80(R25), (R23, R24)
+
+	SUBS R12, R0, R0
+	SBCS R13, R1, R1
+	SBCS R14, R2, R2
+	SBCS R15, R3, R3
+	SBCS R16, R4, R4
+	SBCS R17, R5, R5
+	SBCS R19, R6, R6
+	SBCS R20, R7, R7
+	SBCS R21, R8, R8
+	SBCS R22, R9, R9
+	SBCS R23, R10, R10
+	SBCS R24, R11, R11
+
+	// | add q back if there is a borrow
+	MOVD $·modulus(SB), R25
+	LDP 0(R25), (R12, R13)
+	LDP 16(R25), (R14, R15)
+	LDP 32(R25), (R16, R17)
+	LDP 48(R25), (R19, R20)
+	LDP 64(R25), (R21, R22)
+	LDP 80(R25), (R23, R24)
+	CSEL CS, ZR, R12, R12
+	CSEL CS, ZR, R13, R13
+	CSEL CS, ZR, R14, R14
+	CSEL CS, ZR, R15, R15
+	CSEL CS, ZR, R16, R16
+	CSEL CS, ZR, R17, R17
+	CSEL CS, ZR, R19, R19
+	CSEL CS, ZR, R20, R20
+	CSEL CS, ZR, R21, R21
+	CSEL CS, ZR, R22, R22
+	CSEL CS, ZR, R23, R23
+	CSEL CS, ZR, R24, R24
+	ADDS R12, R0, R0
+	ADCS R13, R1, R1
+	ADCS R14, R2, R2
+	ADCS R15, R3, R3
+	ADCS R16, R4, R4
+	ADCS R17, R5, R5
+	ADCS R19, R6, R6
+	ADCS R20, R7, R7
+	ADCS R21, R8, R8
+	ADCS R22, R9, R9
+	ADCS R23, R10, R10
+	ADCS R24, R11, R11
+
+	MOVD c+0(FP), R25
+	STP (R0, R1), 0(R25)
+	STP (R2, R3), 16(R25)
+	STP (R4, R5), 32(R25)
+	STP (R6, R7), 48(R25)
+	STP (R8, R9), 64(R25)
+	STP (R10, R11), 80(R25)
+	RET
+/*	 | end													*/
+
+
+// a =  (a - b) % q
+// func subAssign(a *[12]uint64, b *[12]uint64)
+TEXT ·subAssign(SB), NOSPLIT, $0-16
+	MOVD a+0(FP), R25
+	LDP 0(R25), (R0, R1)
+	LDP 16(R25), (R2, R3)
+	LDP 32(R25), (R4, R5)
+	LDP 48(R25), (R6, R7)
+	LDP 64(R25), (R8, R9)
+	LDP 80(R25), (R10, R11)
+	MOVD b+8(FP), R25
+	LDP 0(R25), (R12, R13)
+	LDP 16(R25), (R14, R15)
+	LDP 32(R25), (R16, R17)
+	LDP 48(R25), (R19, R20)
+	LDP 64(R25), (R21, R22)
+	LDP 80(R25), (R23, R24)
+
+	SUBS R12, R0, R0
+	SBCS R13, R1, R1
+	SBCS R14, R2, R2
+	SBCS R15, R3, R3
+	SBCS R16, R4, R4
+	SBCS R17, R5, R5
+	SBCS R19, R6, R6
+	SBCS R20, R7, R7
+	SBCS R21, R8, R8
+	SBCS R22, R9, R9
+	SBCS R23, R10, R10
+	SBCS R24, R11, R11
+
+	// | add q back if there is a borrow
+	MOVD $·modulus(SB), R25
+	LDP 0(R25), (R12, R13)
+	LDP 16(R25), (R14, R15)
+	LDP 32(R25), (R16, R17)
+	LDP 48(R25), (R19, R20)
+	LDP 64(R25), (R21, R22)
+	LDP 80(R25), (R23, R24)
+	CSEL CS, ZR, R12, R12
+	CSEL CS, ZR, R13, R13
+	CSEL CS, ZR, R14, R14
+	CSEL CS, ZR, R15, R15
+	CSEL CS, ZR, R16, R16
+	CSEL CS, ZR, R17, R17
+	CSEL CS, ZR, R19, R19
+	CSEL CS, ZR, R20, R20
+	CSEL CS, ZR, R21, R21
+	CSEL CS, ZR, R22, R22
+	CSEL CS, ZR, R23, R23
+	CSEL CS, ZR, R24, R24
+	ADDS R12, R0, R0
+	ADCS R13, R1, R1
+	ADCS R14, R2, R2
+	ADCS R15, R3, R3
+	ADCS R16, R4, R4
+	ADCS R17, R5, R5
+	ADCS R19, R6, R6
+	ADCS R20, R7, R7
+	ADCS R21, R8, R8
+	ADCS R22, R9, R9
+	ADCS R23, R10, R10
+	ADCS R24, R11, R11
+
+	MOVD a+0(FP), R25
+	STP (R0, R1), 0(R25)
+	STP (R2, R3), 16(R25)
+	STP (R4, R5), 32(R25)
+	STP (R6, R7), 48(R25)
+	STP (R8, R9), 64(R25)
+	STP (R10, R11), 80(R25)
+	RET
+/*	 | end													*/
+
+
+// c =  (a - b)
+// func lsub(c *[12]uint64, a *[12]uint64, b *[12]uint64)
+TEXT ·lsub(SB), NOSPLIT, $0-24
+	MOVD a+8(FP), R25
+	LDP 0(R25), (R0, R1)
+	LDP 16(R25), (R2, R3)
+	LDP 32(R25), (R4, R5)
+	LDP 48(R25), (R6, R7)
+	LDP 64(R25), (R8, R9)
+	LDP 80(R25), (R10, R11)
+	MOVD b+16(FP), R25
+	LDP 0(R25), (R12, R13)
+	LDP 16(R25), (R14, R15)
+	LDP 32(R25), (R16, R17)
+	LDP 48(R25), (R19, R20)
+	LDP 64(R25), (R21, R22)
+	LDP 80(R25), (R23, R24)
+
+	SUBS R12, R0, R0
+	SBCS R13, R1, R1
+	SBCS R14, R2, R2
+	SBCS R15, R3, R3
+	SBCS R16, R4, R4
+	SBCS R17, R5, R5
+	SBCS R19, R6, R6
+	SBCS R20, R7, R7
+	SBCS R21, R8, R8
+	SBCS R22, R9, R9
+	SBCS R23, R10, R10
+	SBCS R24, R11, R11
+
+	MOVD c+0(FP), R25
+	STP (R0, R1), 0(R25)
+	STP (R2, R3), 16(R25)
+	STP (R4, R5), 32(R25)
+	STP (R6, R7), 48(R25)
+	STP (R8, R9), 64(R25)
+	STP (R10, R11), 80(R25)
+	RET
+/*	 | end													*/
+
+
+// a =  (a - b)
+// func lsubAssign(a *[12]uint64, b *[12]uint64)
+TEXT ·lsubAssign(SB), NOSPLIT, $0-16
+	MOVD a+0(FP), R25
+	LDP 0(R25), (R0, R1)
+	LDP 16(R25), (R2, R3)
+	LDP 32(R25), (R4, R5)
+	LDP 48(R25), (R6, R7)
+	LDP 64(R25), (R8, R9)
+	LDP 80(R25), (R10, R11)
+	MOVD b+8(FP), R25
+	LDP 0(R25), (R12, R13)
+	LDP 16(R25), (R14, R15)
+	LDP 32(R25), (R16, R17)
+	LDP 48(R25), (R19, R20)
+	LDP 64(R25), (R21, R22)
+	LDP 80(R25), (R23, R24)
+
+	SUBS R12, R0, R0
+	SBCS R13, R1, R1
+	SBCS R14, R2, R2
+	SBCS R15, R3, R3
+	SBCS R16, R4, R4
+	SBCS R17, R5, R5
+	SBCS R19, R6, R6
+	SBCS R20, R7, R7
+	SBCS R21, R8, R8
+	SBCS R22, R9, R9
+	SBCS R23, R10, R10
+	SBCS R24, R11, R11
+
+	MOVD a+0(FP), R25
+	STP (R0, R1), 0(R25)
+	STP (R2, R3), 16(R25)
+	STP (R4, R5), 32(R25)
+	STP (R6, R7), 48(R25)
+	STP (R8, R9), 64(R25)
+	STP (R10, R11), 80(R25)
+	RET
+/*	 | end													*/
+
+
+// c = -a
+// func _neg(c *[12]uint64, a *[12]uint64)
+TEXT ·_neg(SB), NOSPLIT, $0-16
+	MOVD $·modulus(SB), R25
+	LDP 0(R25), (R0, R1)
+	LDP 16(R25), (R2, R3)
+	LDP 32(R25), (R4, R5)
+	LDP 48(R25), (R6, R7)
+	LDP 64(R25), (R8, R9)
+	LDP 80(R25), (R10, R11)
+	MOVD a+8(FP), R25
+	LDP 0(R25), (R12, R13)
+	LDP 16(R25), (R14, R15)
+	LDP 32(R25), (R16, R17)
+	LDP 48(R25), (R19, R20)
+	LDP 64(R25), (R21, R22)
+	LDP 80(R25), (R23, R24)
+
+	SUBS R12, R0, R0
+	SBCS R13, R1, R1
+	SBCS R14, R2, R2
+	SBCS R15, R3, R3
+	SBCS R16, R4, R4
+	SBCS R17, R5, R5
+	SBCS R19, R6, R6
+	SBCS R20, R7, R7
+	SBCS R21, R8, R8
+	SBCS R22, R9, R9
+	SBCS R23, R10, R10
+	SBCS R24, R11, R11
+
+	MOVD c+0(FP), R25
+	STP (R0, R1), 0(R25)
+	STP (R2, R3), 16(R25)
+	STP (R4, R5), 32(R25)
+	STP (R6, R7), 48(R25)
+	STP (R8, R9), 64(R25)
+	STP (R10, R11), 80(R25)
+	RET
+/*	 | end													*/
+
+
+// c = (a * b) % q
+// Montgomery multiplication with no-carry CIOS
+// func mul(c *[12]uint64, a *[12]uint64, b *[12]uint64)
+TEXT ·mul(SB), NOSPLIT, $0-24
+	MOVD a+8(FP), R12
+	MOVD b+16(FP), R13
+	MOVD $·modulus(SB), R24
+	MOVD ·inp(SB), R23
+
+	// | round 0
+	MOVD 0(R12), R14
+	MOVD 0(R13), R15
+	MOVD 0(R24), R25
+	MUL R15, R14, R16
+	UMULH R15, R14, R17
+	MUL R23, R16, R20
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	CMN R16, R21
+	ADC ZR, R22, R19
+	MOVD 8(R13), R15
+	MOVD 8(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R0
+	ADC ZR, R22, R19
+	MOVD 16(R13), R15
+	MOVD 16(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R1
+	ADC ZR, R22, R19
+	MOVD 24(R13), R15
+	MOVD 24(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R2
+	ADC ZR, R22, R19
+	MOVD 32(R13), R15
+	MOVD 32(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R3
+	ADC ZR, R22, R19
+	MOVD 40(R13), R15
+	MOVD 40(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R4
+	ADC ZR, R22, R19
+	MOVD 48(R13), R15
+	MOVD 48(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R5
+	ADC ZR, R22, R19
+	MOVD 56(R13), R15
+	MOVD 56(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R6
+	ADC ZR, R22, R19
+	MOVD 64(R13), R15
+	MOVD 64(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R7
+	ADC ZR, R22, R19
+	MOVD 72(R13), R15
+	MOVD 72(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R8
+	ADC ZR, R22, R19
+	MOVD 80(R13), R15
+	MOVD 80(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R9
+	ADC ZR, R22, R19
+	MOVD 88(R13), R15
+	MOVD 88(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R16, R21, R21
+	ADC ZR, R22, R22
+	ADDS R19, R21, R10
+	ADC R17, R22, R11
+
+	// | round 1
+	MOVD 8(R12), R14
+	MOVD 0(R13), R15
+	MOVD 0(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R0, R21, R16
+	ADC ZR, R22, R17
+	MUL R23, R16, R20
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	CMN R16, R21
+	ADC ZR, R22, R19
+	MOVD 8(R13), R15
+	MOVD 8(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R1, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R0
+	ADC ZR, R22, R19
+	MOVD 16(R13), R15
+	MOVD 16(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R2, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R1
+	ADC ZR, R22, R19
+	MOVD 24(R13), R15
+	MOVD 24(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R3, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R2
+	ADC ZR, R22, R19
+	MOVD 32(R13), R15
+	MOVD 32(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R4, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R3
+	ADC ZR, R22, R19
+	MOVD 40(R13), R15
+	MOVD 40(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R5, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R4
+	ADC ZR, R22, R19
+	MOVD 48(R13), R15
+	MOVD 48(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R6, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R5
+	ADC ZR, R22, R19
+	MOVD 56(R13), R15
+	MOVD 56(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R7, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R6
+	ADC ZR, R22, R19
+	MOVD 64(R13), R15
+	MOVD 64(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R8, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R7
+	ADC ZR, R22, R19
+	MOVD 72(R13), R15
+	MOVD 72(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R9, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R8
+	ADC ZR, R22, R19
+	MOVD 80(R13), R15
+	MOVD 80(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R10, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R9
+	ADC ZR, R22, R19
+	MOVD 88(R13), R15
+	MOVD 88(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R11, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R16, R21, R21
+	ADC ZR, R22, R22
+	ADDS R19, R21, R10
+	ADC R17, R22, R11
+
+	// | round 2
+	MOVD 16(R12), R14
+	MOVD 0(R13), R15
+	MOVD 0(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R0, R21, R16
+	ADC ZR, R22, R17
+	MUL R23, R16, R20
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	CMN R16, R21
+	ADC ZR, R22, R19
+	MOVD 8(R13), R15
+	MOVD 8(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R1, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R0
+	ADC ZR, R22, R19
+	MOVD 16(R13), R15
+	MOVD 16(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R2, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R1
+	ADC ZR, R22, R19
+	MOVD 24(R13), R15
+	MOVD 24(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R3, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R2
+	ADC ZR, R22, R19
+	MOVD 32(R13), R15
+	MOVD 32(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R4, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R3
+	ADC ZR, R22, R19
+	MOVD 40(R13), R15
+	MOVD 40(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R5, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R4
+	ADC ZR, R22, R19
+	MOVD 48(R13), R15
+	MOVD 48(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R6, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R5
+	ADC ZR, R22, R19
+	MOVD 56(R13), R15
+	MOVD 56(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R7, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R6
+	ADC ZR, R22, R19
+	MOVD 64(R13), R15
+	MOVD 64(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R8, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R7
+	ADC ZR, R22, R19
+	MOVD 72(R13), R15
+	MOVD 72(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R9, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R8
+	ADC ZR, R22, R19
+	MOVD 80(R13), R15
+	MOVD 80(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R10, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R9
+	ADC ZR, R22, R19
+	MOVD 88(R13), R15
+	MOVD 88(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R11, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R16, R21, R21
+	ADC ZR, R22, R22
+	ADDS R19, R21, R10
+	ADC R17, R22, R11
+
+	// | round 3
+	MOVD 24(R12), R14
+	MOVD 0(R13), R15
+	MOVD 0(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R0, R21, R16
+	ADC ZR, R22, R17
+	MUL R23, R16, R20
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	CMN R16, R21
+	ADC ZR, R22, R19
+	MOVD 8(R13), R15
+	MOVD 8(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R1, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R0
+	ADC ZR, R22, R19
+	MOVD 16(R13), R15
+	MOVD 16(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R2, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R1
+	ADC ZR, R22, R19
+	MOVD 24(R13), R15
+	MOVD 24(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R3, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R2
+	ADC ZR, R22, R19
+	MOVD 32(R13), R15
+	MOVD 32(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R4, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R3
+	ADC ZR, R22, R19
+	MOVD 40(R13), R15
+	MOVD 40(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R5, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R4
+	ADC ZR, R22, R19
+	MOVD 48(R13), R15
+	MOVD 48(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R6, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R5
+	ADC ZR, R22, R19
+	MOVD 56(R13), R15
+	MOVD 56(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R7, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R6
+	ADC ZR, R22, R19
+	MOVD 64(R13), R15
+	MOVD 64(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R8, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R7
+	ADC ZR, R22, R19
+	MOVD 72(R13), R15
+	MOVD 72(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R9, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R8
+	ADC ZR, R22, R19
+	MOVD 80(R13), R15
+	MOVD 80(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R10, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R9
+	ADC ZR, R22, R19
+	MOVD 88(R13), R15
+	MOVD 88(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R11, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R16, R21, R21
+	ADC ZR, R22, R22
+	ADDS R19, R21, R10
+	ADC R17, R22, R11
+
+	// | round 4
+	MOVD 32(R12), R14
+	MOVD 0(R13), R15
+	MOVD 0(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R0, R21, R16
+	ADC ZR, R22, R17
+	MUL R23, R16, R20
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	CMN R16, R21
+	ADC ZR, R22, R19
+	MOVD 8(R13), R15
+	MOVD 8(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R1, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R0
+	ADC ZR, R22, R19
+	MOVD 16(R13), R15
+	MOVD 16(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R2, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R1
+	ADC ZR, R22, R19
+	MOVD 24(R13), R15
+	MOVD 24(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R3, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R2
+	ADC ZR, R22, R19
+	MOVD 32(R13), R15
+	MOVD 32(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R4, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R3
+	ADC ZR, R22, R19
+	MOVD 40(R13), R15
+	MOVD 40(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R5, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R4
+	ADC ZR, R22, R19
+	MOVD 48(R13), R15
+	MOVD 48(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R6, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R5
+	ADC ZR, R22, R19
+	MOVD 56(R13), R15
+	MOVD 56(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R7, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R6
+	ADC ZR, R22, R19
+	MOVD 64(R13), R15
+	MOVD 64(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R8, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R7
+	ADC ZR, R22, R19
+	MOVD 72(R13), R15
+	MOVD 72(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R9, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R8
+	ADC ZR, R22, R19
+	MOVD 80(R13), R15
+	MOVD 80(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R10, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R9
+	ADC ZR, R22, R19
+	MOVD 88(R13), R15
+	MOVD 88(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R11, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R16, R21, R21
+	ADC ZR, R22, R22
+	ADDS R19, R21, R10
+	ADC R17, R22, R11
+
+	// | round 5
+	MOVD 40(R12), R14
+	MOVD 0(R13), R15
+	MOVD 0(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R0, R21, R16
+	ADC ZR, R22, R17
+	MUL R23, R16, R20
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	CMN R16, R21
+	ADC ZR, R22, R19
+	MOVD 8(R13), R15
+	MOVD 8(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R1, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R0
+	ADC ZR, R22, R19
+	MOVD 16(R13), R15
+	MOVD 16(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R2, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R1
+	ADC ZR, R22, R19
+	MOVD 24(R13), R15
+	MOVD 24(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R3, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R2
+	ADC ZR, R22, R19
+	MOVD 32(R13), R15
+	MOVD 32(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R4, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R3
+	ADC ZR, R22, R19
+	MOVD 40(R13), R15
+	MOVD 40(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R5, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R4
+	ADC ZR, R22, R19
+	MOVD 48(R13), R15
+	MOVD 48(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R6, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R5
+	ADC ZR, R22, R19
+	MOVD 56(R13), R15
+	MOVD 56(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R7, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R6
+	ADC ZR, R22, R19
+	MOVD 64(R13), R15
+	MOVD 64(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R8, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R7
+	ADC ZR, R22, R19
+	MOVD 72(R13), R15
+	MOVD 72(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R9, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R8
+	ADC ZR, R22, R19
+	MOVD 80(R13), R15
+	MOVD 80(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R10, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R9
+	ADC ZR, R22, R19
+	MOVD 88(R13), R15
+	MOVD 88(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R11, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R16, R21, R21
+	ADC ZR, R22, R22
+	ADDS R19, R21, R10
+	ADC R17, R22, R11
+
+	// | round 6
+	MOVD 48(R12), R14
+	MOVD 0(R13), R15
+	MOVD 0(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R0, R21, R16
+	ADC ZR, R22, R17
+	MUL R23, R16, R20
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	CMN R16, R21
+	ADC ZR, R22, R19
+	MOVD 8(R13), R15
+	MOVD 8(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R1, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R0
+	ADC ZR, R22, R19
+	MOVD 16(R13), R15
+	MOVD 16(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R2, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R1
+	ADC ZR, R22, R19
+	MOVD 24(R13), R15
+	MOVD 24(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R3, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R2
+	ADC ZR, R22, R19
+	MOVD 32(R13), R15
+	MOVD 32(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R4, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R3
+	ADC ZR, R22, R19
+	MOVD 40(R13), R15
+	MOVD 40(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R5, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R4
+	ADC ZR, R22, R19
+	MOVD 48(R13), R15
+	MOVD 48(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R6, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R5
+	ADC ZR, R22, R19
+	MOVD 56(R13), R15
+	MOVD 56(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R7, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R6
+	ADC ZR, R22, R19
+	MOVD 64(R13), R15
+	MOVD 64(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R8, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R7
+	ADC ZR, R22, R19
+	MOVD 72(R13), R15
+	MOVD 72(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R9, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R8
+	ADC ZR, R22, R19
+	MOVD 80(R13), R15
+	MOVD 80(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R10, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R9
+	ADC ZR, R22, R19
+	MOVD 88(R13), R15
+	MOVD 88(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R11, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R16, R21, R21
+	ADC ZR, R22, R22
+	ADDS R19, R21, R10
+	ADC R17, R22, R11
+
+	// | round 7
+	MOVD 56(R12), R14
+	MOVD 0(R13), R15
+	MOVD 0(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R0, R21, R16
+	ADC ZR, R22, R17
+	MUL R23, R16, R20
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	CMN R16, R21
+	ADC ZR, R22, R19
+	MOVD 8(R13), R15
+	MOVD 8(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R1, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R0
+	ADC ZR, R22, R19
+	MOVD 16(R13), R15
+	MOVD 16(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R2, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R1
+	ADC ZR, R22, R19
+	MOVD 24(R13), R15
+	MOVD 24(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R3, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R2
+	ADC ZR, R22, R19
+	MOVD 32(R13), R15
+	MOVD 32(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R4, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R3
+	ADC ZR, R22, R19
+	MOVD 40(R13), R15
+	MOVD 40(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R5, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R4
+	ADC ZR, R22, R19
+	MOVD 48(R13), R15
+	MOVD 48(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R6, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R5
+	ADC ZR, R22, R19
+	MOVD 56(R13), R15
+	MOVD 56(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R7, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R6
+	ADC ZR, R22, R19
+	MOVD 64(R13), R15
+	MOVD 64(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R8, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R7
+	ADC ZR, R22, R19
+	MOVD 72(R13), R15
+	MOVD 72(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R9, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R8
+	ADC ZR, R22, R19
+	MOVD 80(R13), R15
+	MOVD 80(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R10, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R9
+	ADC ZR, R22, R19
+	MOVD 88(R13), R15
+	MOVD 88(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R11, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R16, R21, R21
+	ADC ZR, R22, R22
+	ADDS R19, R21, R10
+	ADC R17, R22, R11
+
+	// | round 8
+	MOVD 64(R12), R14
+	MOVD 0(R13), R15
+	MOVD 0(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R0, R21, R16
+	ADC ZR, R22, R17
+	MUL R23, R16, R20
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	CMN R16, R21
+	ADC ZR, R22, R19
+	MOVD 8(R13), R15
+	MOVD 8(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R1, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R0
+	ADC ZR, R22, R19
+	MOVD 16(R13), R15
+	MOVD 16(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R2, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R1
+	ADC ZR, R22, R19
+	MOVD 24(R13), R15
+	MOVD 24(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R3, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R2
+	ADC ZR, R22, R19
+	MOVD 32(R13), R15
+	MOVD 32(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R4, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R3
+	ADC ZR, R22, R19
+	MOVD 40(R13), R15
+	MOVD 40(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R5, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R4
+	ADC ZR, R22, R19
+	MOVD 48(R13), R15
+	MOVD 48(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R6, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R5
+	ADC ZR, R22, R19
+	MOVD 56(R13), R15
+	MOVD 56(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R7, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R6
+	ADC ZR, R22, R19
+	MOVD 64(R13), R15
+	MOVD 64(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R8, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R7
+	ADC ZR, R22, R19
+	MOVD 72(R13), R15
+	MOVD 72(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R9, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R8
+	ADC ZR, R22, R19
+	MOVD 80(R13), R15
+	MOVD 80(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R10, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R9
+	ADC ZR, R22, R19
+	MOVD 88(R13), R15
+	MOVD 88(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R11, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R16, R21, R21
+	ADC ZR, R22, R22
+	ADDS R19, R21, R10
+	ADC R17, R22, R11
+
+	// | round 9
+	MOVD 72(R12), R14
+	MOVD 0(R13), R15
+	MOVD 0(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R0, R21, R16
+	ADC ZR, R22, R17
+	MUL R23, R16, R20
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	CMN R16, R21
+	ADC ZR, R22, R19
+	MOVD 8(R13), R15
+	MOVD 8(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R1, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R0
+	ADC ZR, R22, R19
+	MOVD 16(R13), R15
+	MOVD 16(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R2, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R1
+	ADC ZR, R22, R19
+	MOVD 24(R13), R15
+	MOVD 24(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R3, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R2
+	ADC ZR, R22, R19
+	MOVD 32(R13), R15
+	MOVD 32(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R4, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R3
+	ADC ZR, R22, R19
+	MOVD 40(R13), R15
+	MOVD 40(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R5, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R4
+	ADC ZR, R22, R19
+	MOVD 48(R13), R15
+	MOVD 48(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R6, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R5
+	ADC ZR, R22, R19
+	MOVD 56(R13), R15
+	MOVD 56(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R7, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R6
+	ADC ZR, R22, R19
+	MOVD 64(R13), R15
+	MOVD 64(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R8, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R7
+	ADC ZR, R22, R19
+	MOVD 72(R13), R15
+	MOVD 72(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R9, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R8
+	ADC ZR, R22, R19
+	MOVD 80(R13), R15
+	MOVD 80(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R10, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R9
+	ADC ZR, R22, R19
+	MOVD 88(R13), R15
+	MOVD 88(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R11, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R16, R21, R21
+	ADC ZR, R22, R22
+	ADDS R19, R21, R10
+	ADC R17, R22, R11
+
+	// | round 10
+	MOVD 80(R12), R14
+	MOVD 0(R13), R15
+	MOVD 0(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R0, R21, R16
+	ADC ZR, R22, R17
+	MUL R23, R16, R20
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	CMN R16, R21
+	ADC ZR, R22, R19
+	MOVD 8(R13), R15
+	MOVD 8(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R1, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R0
+	ADC ZR, R22, R19
+	MOVD 16(R13), R15
+	MOVD 16(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R2, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R1
+	ADC ZR, R22, R19
+	MOVD 24(R13), R15
+	MOVD 24(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R3, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R2
+	ADC ZR, R22, R19
+	MOVD 32(R13), R15
+	MOVD 32(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R4, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R3
+	ADC ZR, R22, R19
+	MOVD 40(R13), R15
+	MOVD 40(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R5, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R4
+	ADC ZR, R22, R19
+	MOVD 48(R13), R15
+	MOVD 48(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R6, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R5
+	ADC ZR, R22, R19
+	MOVD 56(R13), R15
+	MOVD 56(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R7, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R6
+	ADC ZR, R22, R19
+	MOVD 64(R13), R15
+	MOVD 64(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R8, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R7
+	ADC ZR, R22, R19
+	MOVD 72(R13), R15
+	MOVD 72(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R9, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R8
+	ADC ZR, R22, R19
+	MOVD 80(R13), R15
+	MOVD 80(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R10, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R9
+	ADC ZR, R22, R19
+	MOVD 88(R13), R15
+	MOVD 88(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R11, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R16, R21, R21
+	ADC ZR, R22, R22
+	ADDS R19, R21, R10
+	ADC R17, R22, R11
+
+	// | round 11
+	MOVD 88(R12), R14
+	MOVD 0(R13), R15
+	MOVD 0(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R0, R21, R16
+	ADC ZR, R22, R17
+	MUL R23, R16, R20
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	CMN R16, R21
+	ADC ZR, R22, R19
+	MOVD 8(R13), R15
+	MOVD 8(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R1, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R0
+	ADC ZR, R22, R19
+	MOVD 16(R13), R15
+	MOVD 16(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R2, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R1
+	ADC ZR, R22, R19
+	MOVD 24(R13), R15
+	MOVD 24(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R3, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R2
+	ADC ZR, R22, R19
+	MOVD 32(R13), R15
+	MOVD 32(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R4, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R3
+	ADC ZR, R22, R19
+	MOVD 40(R13), R15
+	MOVD 40(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R5, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R4
+	ADC ZR, R22, R19
+	MOVD 48(R13), R15
+	MOVD 48(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R6, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R5
+	ADC ZR, R22, R19
+	MOVD 56(R13), R15
+	MOVD 56(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R7, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R6
+	ADC ZR, R22, R19
+	MOVD 64(R13), R15
+	MOVD 64(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R8, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R7
+	ADC ZR, R22, R19
+	MOVD 72(R13), R15
+	MOVD 72(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R9, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R8
+	ADC ZR, R22, R19
+	MOVD 80(R13), R15
+	MOVD 80(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R10, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R19, R21, R21
+	ADC ZR, R22, R22
+	ADDS R16, R21, R9
+	ADC ZR, R22, R19
+	MOVD 88(R13), R15
+	MOVD 88(R24), R25
+	MUL R15, R14, R21
+	UMULH R15, R14, R22
+	ADDS R17, R21, R21
+	ADC ZR, R22, R22
+	ADDS R11, R21, R16
+	ADC ZR, R22, R17
+	MUL R25, R20, R21
+	UMULH R25, R20, R22
+	ADDS R16, R21, R21
+	ADC ZR, R22, R22
+	ADDS R19, R21, R10
+	ADC R17, R22, R11
+
+	// | t - q
+	MOVD R24, R25
+	LDP 0(R25), (R12, R13)
+	LDP 16(R25), (R14, R15)
+	LDP 32(R25), (R16, R17)
+	LDP 48(R25), (R19, R20)
+	LDP 64(R25), (R21, R22)
+	LDP 80(R25), (R23, R24)
+	SUBS R12, R0, R12
+	SBCS R13, R1, R13
+	SBCS R14, R2, R14
+	SBCS R15, R3, R15
+	SBCS R16, R4, R16
+	SBCS R17, R5, R17
+	SBCS R19, R6, R19
+	SBCS R20, R7, R20
+	SBCS R21, R8, R21
+	SBCS R22, R9, R22
+	SBCS R23, R10, R23
+	SBCS R24, R11, R24
+
+	// | select
+	CSEL CS, R12, R0, R0
+	CSEL CS, R13, R1, R1
+	CSEL CS, R14, R2, R2
+	CSEL CS, R15, R3, R3
+	CSEL CS, R16, R4, R4
+	CSEL CS, R17, R5, R5
+	CSEL CS, R19, R6, R6
+	CSEL CS, R20, R7, R7
+	CSEL CS, R21, R8, R8
+	CSEL CS, R22, R9, R9
+	CSEL CS, R23, R10, R10
+	CSEL CS, R24, R11, R11
+
+	MOVD c+0(FP), R25
+	STP (R0, R1), 0(R25)
+	STP (R2, R3), 16(R25)
+	STP (R4, R5), 32(R25)
+	STP (R6, R7), 48(R25)
+	STP (R8, R9), 64(R25)
+	STP (R10, R11), 80(R25)
+	RET
+/*	 | end													*/
+
+
+// c = a * b
+// product is not reduced
+// func mulWide(c *[24]uint64, a *[12]uint64, b *[12]uint64)
+TEXT ·mulWide(SB), NOSPLIT, $0-24
+	MOVD a+8(FP), R13
+	MOVD b+16(FP), R14
+	MOVD c+0(FP), R25
+
+	// | round 0
+	MOVD 0(R13), R15
+	MOVD 0(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	MOVD R17, R0
+	MOVD R19, R12
+	MOVD 8(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R12, R17, R1
+	ADC ZR, R19, R12
+	MOVD 16(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R12, R17, R2
+	ADC ZR, R19, R12
+	MOVD 24(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R12, R17, R3
+	ADC ZR, R19, R12
+	MOVD 32(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R12, R17, R4
+	ADC ZR, R19, R12
+	MOVD 40(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R12, R17, R5
+	ADC ZR, R19, R12
+	MOVD 48(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R12, R17, R6
+	ADC ZR, R19, R12
+	MOVD 56(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R12, R17, R7
+	ADC ZR, R19, R12
+	MOVD 64(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R12, R17, R8
+	ADC ZR, R19, R12
+	MOVD 72(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R12, R17, R9
+	ADC ZR, R19, R12
+	MOVD 80(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R12, R17, R10
+	ADC ZR, R19, R12
+	MOVD 88(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R12, R17, R11
+	ADC ZR, R19, R12
+	MOVD R0, 0(R25)
+
+	// | round 1
+	MOVD 8(R13), R15
+	MOVD 0(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R1, R17, R1
+	ADC ZR, R19, R0
+	MOVD 8(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R0, R17, R17
+	ADC ZR, R19, R19
+	ADDS R2, R17, R2
+	ADC ZR, R19, R0
+	MOVD 16(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R0, R17, R17
+	ADC ZR, R19, R19
+	ADDS R3, R17, R3
+	ADC ZR, R19, R0
+	MOVD 24(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R0, R17, R17
+	ADC ZR, R19, R19
+	ADDS R4, R17, R4
+	ADC ZR, R19, R0
+	MOVD 32(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R0, R17, R17
+	ADC ZR, R19, R19
+	ADDS R5, R17, R5
+	ADC ZR, R19, R0
+	MOVD 40(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R0, R17, R17
+	ADC ZR, R19, R19
+	ADDS R6, R17, R6
+	ADC ZR, R19, R0
+	MOVD 48(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R0, R17, R17
+	ADC ZR, R19, R19
+	ADDS R7, R17, R7
+	ADC ZR, R19, R0
+	MOVD 56(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R0, R17, R17
+	ADC ZR, R19, R19
+	ADDS R8, R17, R8
+	ADC ZR, R19, R0
+	MOVD 64(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R0, R17, R17
+	ADC ZR, R19, R19
+	ADDS R9, R17, R9
+	ADC ZR, R19, R0
+	MOVD 72(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R0, R17, R17
+	ADC ZR, R19, R19
+	ADDS R10, R17, R10
+	ADC ZR, R19, R0
+	MOVD 80(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R0, R17, R17
+	ADC ZR, R19, R19
+	ADDS R11, R17, R11
+	ADC ZR, R19, R0
+	MOVD 88(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R0, R17, R17
+	ADC ZR, R19, R19
+	ADDS R12, R17, R12
+	ADC ZR, R19, R0
+	MOVD R1, 8(R25)
+
+	// | round 2
+	MOVD 16(R13), R15
+	MOVD 0(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R2, R17, R2
+	ADC ZR, R19, R1
+	MOVD 8(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R1, R17, R17
+	ADC ZR, R19, R19
+	ADDS R3, R17, R3
+	ADC ZR, R19, R1
+	MOVD 16(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R1, R17, R17
+	ADC ZR, R19, R19
+	ADDS R4, R17, R4
+	ADC ZR, R19, R1
+	MOVD 24(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R1, R17, R17
+	ADC ZR, R19, R19
+	ADDS R5, R17, R5
+	ADC ZR, R19, R1
+	MOVD 32(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R1, R17, R17
+	ADC ZR, R19, R19
+	ADDS R6, R17, R6
+	ADC ZR, R19, R1
+	MOVD 40(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R1, R17, R17
+	ADC ZR, R19, R19
+	ADDS R7, R17, R7
+	ADC ZR, R19, R1
+	MOVD 48(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R1, R17, R17
+	ADC ZR, R19, R19
+	ADDS R8, R17, R8
+	ADC ZR, R19, R1
+	MOVD 56(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R1, R17, R17
+	ADC ZR, R19, R19
+	ADDS R9, R17, R9
+	ADC ZR, R19, R1
+	MOVD 64(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R1, R17, R17
+	ADC ZR, R19, R19
+	ADDS R10, R17, R10
+	ADC ZR, R19, R1
+	MOVD 72(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R1, R17, R17
+	ADC ZR, R19, R19
+	ADDS R11, R17, R11
+	ADC ZR, R19, R1
+	MOVD 80(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R1, R17, R17
+	ADC ZR, R19, R19
+	ADDS R12, R17, R12
+	ADC ZR, R19, R1
+	MOVD 88(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R1, R17, R17
+	ADC ZR, R19, R19
+	ADDS R0, R17, R0
+	ADC ZR, R19, R1
+	MOVD R2, 16(R25)
+
+	// | round 3
+	MOVD 24(R13), R15
+	MOVD 0(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R3, R17, R3
+	ADC ZR, R19, R2
+	MOVD 8(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R2, R17, R17
+	ADC ZR, R19, R19
+	ADDS R4, R17, R4
+	ADC ZR, R19, R2
+	MOVD 16(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R2, R17, R17
+	ADC ZR, R19, R19
+	ADDS R5, R17, R5
+	ADC ZR, R19, R2
+	MOVD 24(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R2, R17, R17
+	ADC ZR, R19, R19
+	ADDS R6, R17, R6
+	ADC ZR, R19, R2
+	MOVD 32(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R2, R17, R17
+	ADC ZR, R19, R19
+	ADDS R7, R17, R7
+	ADC ZR, R19, R2
+	MOVD 40(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R2, R17, R17
+	ADC ZR, R19, R19
+	ADDS R8, R17, R8
+	ADC ZR, R19, R2
+	MOVD 48(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R2, R17, R17
+	ADC ZR, R19, R19
+	ADDS R9, R17, R9
+	ADC ZR, R19, R2
+	MOVD 56(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R2, R17, R17
+	ADC ZR, R19, R19
+	ADDS R10, R17, R10
+	ADC ZR, R19, R2
+	MOVD 64(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R2, R17, R17
+	ADC ZR, R19, R19
+	ADDS R11, R17, R11
+	ADC ZR, R19, R2
+	MOVD 72(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R2, R17, R17
+	ADC ZR, R19, R19
+	ADDS R12, R17, R12
+	ADC ZR, R19, R2
+	MOVD 80(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R2, R17, R17
+	ADC ZR, R19, R19
+	ADDS R0, R17, R0
+	ADC ZR, R19, R2
+	MOVD 88(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R2, R17, R17
+	ADC ZR, R19, R19
+	ADDS R1, R17, R1
+	ADC ZR, R19, R2
+	MOVD R3, 24(R25)
+
+	// | round 4
+	MOVD 32(R13), R15
+	MOVD 0(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R4, R17, R4
+	ADC ZR, R19, R3
+	MOVD 8(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R3, R17, R17
+	ADC ZR, R19, R19
+	ADDS R5, R17, R5
+	ADC ZR, R19, R3
+	MOVD 16(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R3, R17, R17
+	ADC ZR, R19, R19
+	ADDS R6, R17, R6
+	ADC ZR, R19, R3
+	MOVD 24(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R3, R17, R17
+	ADC ZR, R19, R19
+	ADDS R7, R17, R7
+	ADC ZR, R19, R3
+	MOVD 32(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R3, R17, R17
+	ADC ZR, R19, R19
+	ADDS R8, R17, R8
+	ADC ZR, R19, R3
+	MOVD 40(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R3, R17, R17
+	ADC ZR, R19, R19
+	ADDS R9, R17, R9
+	ADC ZR, R19, R3
+	MOVD 48(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R3, R17, R17
+	ADC ZR, R19, R19
+	ADDS R10, R17, R10
+	ADC ZR, R19, R3
+	MOVD 56(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R3, R17, R17
+	ADC ZR, R19, R19
+	ADDS R11, R17, R11
+	ADC ZR, R19, R3
+	MOVD 64(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R3, R17, R17
+	ADC ZR, R19, R19
+	ADDS R12, R17, R12
+	ADC ZR, R19, R3
+	MOVD 72(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R3, R17, R17
+	ADC ZR, R19, R19
+	ADDS R0, R17, R0
+	ADC ZR, R19, R3
+	MOVD 80(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R3, R17, R17
+	ADC ZR, R19, R19
+	ADDS R1, R17, R1
+	ADC ZR, R19, R3
+	MOVD 88(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R3, R17, R17
+	ADC ZR, R19, R19
+	ADDS R2, R17, R2
+	ADC ZR, R19, R3
+	MOVD R4, 32(R25)
+
+	// | round 5
+	MOVD 40(R13), R15
+	MOVD 0(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R5, R17, R5
+	ADC ZR, R19, R4
+	MOVD 8(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R4, R17, R17
+	ADC ZR, R19, R19
+	ADDS R6, R17, R6
+	ADC ZR, R19, R4
+	MOVD 16(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R4, R17, R17
+	ADC ZR, R19, R19
+	ADDS R7, R17, R7
+	ADC ZR, R19, R4
+	MOVD 24(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R4, R17, R17
+	ADC ZR, R19, R19
+	ADDS R8, R17, R8
+	ADC ZR, R19, R4
+	MOVD 32(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R4, R17, R17
+	ADC ZR, R19, R19
+	ADDS R9, R17, R9
+	ADC ZR, R19, R4
+	MOVD 40(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R4, R17, R17
+	ADC ZR, R19, R19
+	ADDS R10, R17, R10
+	ADC ZR, R19, R4
+	MOVD 48(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R4, R17, R17
+	ADC ZR, R19, R19
+	ADDS R11, R17, R11
+	ADC ZR, R19, R4
+	MOVD 56(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R4, R17, R17
+	ADC ZR, R19, R19
+	ADDS R12, R17, R12
+	ADC ZR, R19, R4
+	MOVD 64(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R4, R17, R17
+	ADC ZR, R19, R19
+	ADDS R0, R17, R0
+	ADC ZR, R19, R4
+	MOVD 72(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R4, R17, R17
+	ADC ZR, R19, R19
+	ADDS R1, R17, R1
+	ADC ZR, R19, R4
+	MOVD 80(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R4, R17, R17
+	ADC ZR, R19, R19
+	ADDS R2, R17, R2
+	ADC ZR, R19, R4
+	MOVD 88(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R4, R17, R17
+	ADC ZR, R19, R19
+	ADDS R3, R17, R3
+	ADC ZR, R19, R4
+	MOVD R5, 40(R25)
+
+	// | round 6
+	MOVD 48(R13), R15
+	MOVD 0(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R6, R17, R6
+	ADC ZR, R19, R5
+	MOVD 8(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R5, R17, R17
+	ADC ZR, R19, R19
+	ADDS R7, R17, R7
+	ADC ZR, R19, R5
+	MOVD 16(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R5, R17, R17
+	ADC ZR, R19, R19
+	ADDS R8, R17, R8
+	ADC ZR, R19, R5
+	MOVD 24(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R5, R17, R17
+	ADC ZR, R19, R19
+	ADDS R9, R17, R9
+	ADC ZR, R19, R5
+	MOVD 32(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R5, R17, R17
+	ADC ZR, R19, R19
+	ADDS R10, R17, R10
+	ADC ZR, R19, R5
+	MOVD 40(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R5, R17, R17
+	ADC ZR, R19, R19
+	ADDS R11, R17, R11
+	ADC ZR, R19, R5
+	MOVD 48(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R5, R17, R17
+	ADC ZR, R19, R19
+	ADDS R12, R17, R12
+	ADC ZR, R19, R5
+	MOVD 56(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R5, R17, R17
+	ADC ZR, R19, R19
+	ADDS R0, R17, R0
+	ADC ZR, R19, R5
+	MOVD 64(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R5, R17, R17
+	ADC ZR, R19, R19
+	ADDS R1, R17, R1
+	ADC ZR, R19, R5
+	MOVD 72(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R5, R17, R17
+	ADC ZR, R19, R19
+	ADDS R2, R17, R2
+	ADC ZR, R19, R5
+	MOVD 80(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R5, R17, R17
+	ADC ZR, R19, R19
+	ADDS R3, R17, R3
+	ADC ZR, R19, R5
+	MOVD 88(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R5, R17, R17
+	ADC ZR, R19, R19
+	ADDS R4, R17, R4
+	ADC ZR, R19, R5
+	MOVD R6, 48(R25)
+
+	// | round 7
+	MOVD 56(R13), R15
+	MOVD 0(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R7, R17, R7
+	ADC ZR, R19, R6
+	MOVD 8(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R6, R17, R17
+	ADC ZR, R19, R19
+	ADDS R8, R17, R8
+	ADC ZR, R19, R6
+	MOVD 16(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R6, R17, R17
+	ADC ZR, R19, R19
+	ADDS R9, R17, R9
+	ADC ZR, R19, R6
+	MOVD 24(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R6, R17, R17
+	ADC ZR, R19, R19
+	ADDS R10, R17, R10
+	ADC ZR, R19, R6
+	MOVD 32(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R6, R17, R17
+	ADC ZR, R19, R19
+	ADDS R11, R17, R11
+	ADC ZR, R19, R6
+	MOVD 40(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R6, R17, R17
+	ADC ZR, R19, R19
+	ADDS R12, R17, R12
+	ADC ZR, R19, R6
+	MOVD 48(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R6, R17, R17
+	ADC ZR, R19, R19
+	ADDS R0, R17, R0
+	ADC ZR, R19, R6
+	MOVD 56(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R6, R17, R17
+	ADC ZR, R19, R19
+	ADDS R1, R17, R1
+	ADC ZR, R19, R6
+	MOVD 64(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R6, R17, R17
+	ADC ZR, R19, R19
+	ADDS R2, R17, R2
+	ADC ZR, R19, R6
+	MOVD 72(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R6, R17, R17
+	ADC ZR, R19, R19
+	ADDS R3, R17, R3
+	ADC ZR, R19, R6
+	MOVD 80(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R6, R17, R17
+	ADC ZR, R19, R19
+	ADDS R4, R17, R4
+	ADC ZR, R19, R6
+	MOVD 88(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R6, R17, R17
+	ADC ZR, R19, R19
+	ADDS R5, R17, R5
+	ADC ZR, R19, R6
+	MOVD R7, 56(R25)
+
+	// | round 8
+	MOVD 64(R13), R15
+	MOVD 0(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R8, R17, R8
+	ADC ZR, R19, R7
+	MOVD 8(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R7, R17, R17
+	ADC ZR, R19, R19
+	ADDS R9, R17, R9
+	ADC ZR, R19, R7
+	MOVD 16(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R7, R17, R17
+	ADC ZR, R19, R19
+	ADDS R10, R17, R10
+	ADC ZR, R19, R7
+	MOVD 24(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R7, R17, R17
+	ADC ZR, R19, R19
+	ADDS R11, R17, R11
+	ADC ZR, R19, R7
+	MOVD 32(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R7, R17, R17
+	ADC ZR, R19, R19
+	ADDS R12, R17, R12
+	ADC ZR, R19, R7
+	MOVD 40(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R7, R17, R17
+	ADC ZR, R19, R19
+	ADDS R0, R17, R0
+	ADC ZR, R19, R7
+	MOVD 48(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R7, R17, R17
+	ADC ZR, R19, R19
+	ADDS R1, R17, R1
+	ADC ZR, R19, R7
+	MOVD 56(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R7, R17, R17
+	ADC ZR, R19, R19
+	ADDS R2, R17, R2
+	ADC ZR, R19, R7
+	MOVD 64(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R7, R17, R17
+	ADC ZR, R19, R19
+	ADDS R3, R17, R3
+	ADC ZR, R19, R7
+	MOVD 72(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R7, R17, R17
+	ADC ZR, R19, R19
+	ADDS R4, R17, R4
+	ADC ZR, R19, R7
+	MOVD 80(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R7, R17, R17
+	ADC ZR, R19, R19
+	ADDS R5, R17, R5
+	ADC ZR, R19, R7
+	MOVD 88(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R7, R17, R17
+	ADC ZR, R19, R19
+	ADDS R6, R17, R6
+	ADC ZR, R19, R7
+	MOVD R8, 64(R25)
+
+	// | round 9
+	MOVD 72(R13), R15
+	MOVD 0(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R9, R17, R9
+	ADC ZR, R19, R8
+	MOVD 8(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R8, R17, R17
+	ADC ZR, R19, R19
+	ADDS R10, R17, R10
+	ADC ZR, R19, R8
+	MOVD 16(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R8, R17, R17
+	ADC ZR, R19, R19
+	ADDS R11, R17, R11
+	ADC ZR, R19, R8
+	MOVD 24(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R8, R17, R17
+	ADC ZR, R19, R19
+	ADDS R12, R17, R12
+	ADC ZR, R19, R8
+	MOVD 32(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R8, R17, R17
+	ADC ZR, R19, R19
+	ADDS R0, R17, R0
+	ADC ZR, R19, R8
+	MOVD 40(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R8, R17, R17
+	ADC ZR, R19, R19
+	ADDS R1, R17, R1
+	ADC ZR, R19, R8
+	MOVD 48(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R8, R17, R17
+	ADC ZR, R19, R19
+	ADDS R2, R17, R2
+	ADC ZR, R19, R8
+	MOVD 56(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R8, R17, R17
+	ADC ZR, R19, R19
+	ADDS R3, R17, R3
+	ADC ZR, R19, R8
+	MOVD 64(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R8, R17, R17
+	ADC ZR, R19, R19
+	ADDS R4, R17, R4
+	ADC ZR, R19, R8
+	MOVD 72(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R8, R17, R17
+	ADC ZR, R19, R19
+	ADDS R5, R17, R5
+	ADC ZR, R19, R8
+	MOVD 80(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R8, R17, R17
+	ADC ZR, R19, R19
+	ADDS R6, R17, R6
+	ADC ZR, R19, R8
+	MOVD 88(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R8, R17, R17
+	ADC ZR, R19, R19
+	ADDS R7, R17, R7
+	ADC ZR, R19, R8
+	MOVD R9, 72(R25)
+
+	// | round 10
+	MOVD 80(R13), R15
+	MOVD 0(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R10, R17, R10
+	ADC ZR, R19, R9
+	MOVD 8(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R9, R17, R17
+	ADC ZR, R19, R19
+	ADDS R11, R17, R11
+	ADC ZR, R19, R9
+	MOVD 16(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R9, R17, R17
+	ADC ZR, R19, R19
+	ADDS R12, R17, R12
+	ADC ZR, R19, R9
+	MOVD 24(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R9, R17, R17
+	ADC ZR, R19, R19
+	ADDS R0, R17, R0
+	ADC ZR, R19, R9
+	MOVD 32(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R9, R17, R17
+	ADC ZR, R19, R19
+	ADDS R1, R17, R1
+	ADC ZR, R19, R9
+	MOVD 40(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R9, R17, R17
+	ADC ZR, R19, R19
+	ADDS R2, R17, R2
+	ADC ZR, R19, R9
+	MOVD 48(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R9, R17, R17
+	ADC ZR, R19, R19
+	ADDS R3, R17, R3
+	ADC ZR, R19, R9
+	MOVD 56(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R9, R17, R17
+	ADC ZR, R19, R19
+	ADDS R4, R17, R4
+	ADC ZR, R19, R9
+	MOVD 64(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R9, R17, R17
+	ADC ZR, R19, R19
+	ADDS R5, R17, R5
+	ADC ZR, R19, R9
+	MOVD 72(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R9, R17, R17
+	ADC ZR, R19, R19
+	ADDS R6, R17, R6
+	ADC ZR, R19, R9
+	MOVD 80(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R9, R17, R17
+	ADC ZR, R19, R19
+	ADDS R7, R17, R7
+	ADC ZR, R19, R9
+	MOVD 88(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R9, R17, R17
+	ADC ZR, R19, R19
+	ADDS R8, R17, R8
+	ADC ZR, R19, R9
+	MOVD R10, 80(R25)
+
+	// | round 11
+	MOVD 88(R13), R15
+	MOVD 0(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R11, R17, R11
+	ADC ZR, R19, R10
+	MOVD 8(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R10, R17, R17
+	ADC ZR, R19, R19
+	ADDS R12, R17, R12
+	ADC ZR, R19, R10
+	MOVD 16(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R10, R17, R17
+	ADC ZR, R19, R19
+	ADDS R0, R17, R0
+	ADC ZR, R19, R10
+	MOVD 24(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R10, R17, R17
+	ADC ZR, R19, R19
+	ADDS R1, R17, R1
+	ADC ZR, R19, R10
+	MOVD 32(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R10, R17, R17
+	ADC ZR, R19, R19
+	ADDS R2, R17, R2
+	ADC ZR, R19, R10
+	MOVD 40(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R10, R17, R17
+	ADC ZR, R19, R19
+	ADDS R3, R17, R3
+	ADC ZR, R19, R10
+	MOVD 48(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R10, R17, R17
+	ADC ZR, R19, R19
+	ADDS R4, R17, R4
+	ADC ZR, R19, R10
+	MOVD 56(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R10, R17, R17
+	ADC ZR, R19, R19
+	ADDS R5, R17, R5
+	ADC ZR, R19, R10
+	MOVD 64(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R10, R17, R17
+	ADC ZR, R19, R19
+	ADDS R6, R17, R6
+	ADC ZR, R19, R10
+	MOVD 72(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R10, R17, R17
+	ADC ZR, R19, R19
+	ADDS R7, R17, R7
+	ADC ZR, R19, R10
+	MOVD 80(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R10, R17, R17
+	ADC ZR, R19, R19
+	ADDS R8, R17, R8
+	ADC ZR, R19, R10
+	MOVD 88(R14), R16
+	MUL R16, R15, R17
+	UMULH R16, R15, R19
+	ADDS R10, R17, R17
+	ADC ZR, R19, R19
+	ADDS R9, R17, R9
+	ADC ZR, R19, R10
+	MOVD R11, 88(R25)
+
+	STP (R12, R0), 96(R25)
+	STP (R1, R2), 112(R25)
+	STP (R3, R4), 128(R25)
+	STP (R5, R6), 144(R25)
+	STP (R7, R8), 160(R25)
+	STP (R9, R10), 176(R25)
+	RET
+/*	 | end													*/
+
+
+// c = a % q
+// a is expected to be less than q * 2^768
+// func montRed(c *[12]uint64, a *[24]uint64)
+TEXT ·montRed(SB), NOSPLIT, $0-16
+	MOVD a+8(FP), R13
+	MOVD $·modulus(SB), R23
+	MOVD ·inp(SB), R22
+	LDP 0(R13), (R0, R1)
+	LDP 16(R13), (R2, R3)
+	LDP 32(R13), (R4, R5)
+	LDP 48(R13), (R6, R7)
+	LDP 64(R13), (R8, R9)
+	LDP 80(R13), (R10, R11)
+	MOVD ZR, R16
+
+	// | round 0
+	MUL R22, R0, R14
+	MOVD 0(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	CMN R0, R19
+	ADC ZR, R20, R15
+	MOVD 8(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R1, R19, R1
+	ADC ZR, R20, R15
+	MOVD 16(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R2, R19, R2
+	ADC ZR, R20, R15
+	MOVD 24(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R3, R19, R3
+	ADC ZR, R20, R15
+	MOVD 32(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R4, R19, R4
+	ADC ZR, R20, R15
+	MOVD 40(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R5, R19, R5
+	ADC ZR, R20, R15
+	MOVD 48(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R6, R19, R6
+	ADC ZR, R20, R15
+	MOVD 56(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R7, R19, R7
+	ADC ZR, R20, R15
+	MOVD 64(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R8, R19, R8
+	ADC ZR, R20, R15
+	MOVD 72(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R9, R19, R9
+	ADC ZR, R20, R15
+	MOVD 80(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R10, R19, R10
+	ADC ZR, R20, R15
+	MOVD 88(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R11, R19, R11
+	ADC ZR, R20, R15
+	MOVD 96(R13), R0
+	ADDS R15, R0, R0
+	ADC ZR, ZR, R17
+	ADDS R16, R0, R0
+	ADC ZR, R17, R16
+
+	// | round 1
+	MUL R22, R1, R14
+	MOVD 0(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	CMN R1, R19
+	ADC ZR, R20, R15
+	MOVD 8(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R2, R19, R2
+	ADC ZR, R20, R15
+	MOVD 16(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R3, R19, R3
+	ADC ZR, R20, R15
+	MOVD 24(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R4, R19, R4
+	ADC ZR, R20, R15
+	MOVD 32(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R5, R19, R5
+	ADC ZR, R20, R15
+	MOVD 40(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R6, R19, R6
+	ADC ZR, R20, R15
+	MOVD 48(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R7, R19, R7
+	ADC ZR, R20, R15
+	MOVD 56(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R8, R19, R8
+	ADC ZR, R20, R15
+	MOVD 64(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R9, R19, R9
+	ADC ZR, R20, R15
+	MOVD 72(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R10, R19, R10
+	ADC ZR, R20, R15
+	MOVD 80(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R11, R19, R11
+	ADC ZR, R20, R15
+	MOVD 88(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R0, R19, R0
+	ADC ZR, R20, R15
+	MOVD 104(R13), R1
+	ADDS R15, R1, R1
+	ADC ZR, ZR, R17
+	ADDS R16, R1, R1
+	ADC ZR, R17, R16
+
+	// | round 2
+	MUL R22, R2, R14
+	MOVD 0(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	CMN R2, R19
+	ADC ZR, R20, R15
+	MOVD 8(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R3, R19, R3
+	ADC ZR, R20, R15
+	MOVD 16(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R4, R19, R4
+	ADC ZR, R20, R15
+	MOVD 24(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R5, R19, R5
+	ADC ZR, R20, R15
+	MOVD 32(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R6, R19, R6
+	ADC ZR, R20, R15
+	MOVD 40(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R7, R19, R7
+	ADC ZR, R20, R15
+	MOVD 48(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R8, R19, R8
+	ADC ZR, R20, R15
+	MOVD 56(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R9, R19, R9
+	ADC ZR, R20, R15
+	MOVD 64(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R10, R19, R10
+	ADC ZR, R20, R15
+	MOVD 72(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R11, R19, R11
+	ADC ZR, R20, R15
+	MOVD 80(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R0, R19, R0
+	ADC ZR, R20, R15
+	MOVD 88(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R1, R19, R1
+	ADC ZR, R20, R15
+	MOVD 112(R13), R2
+	ADDS R15, R2, R2
+	ADC ZR, ZR, R17
+	ADDS R16, R2, R2
+	ADC ZR, R17, R16
+
+	// | round 3
+	MUL R22, R3, R14
+	MOVD 0(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	CMN R3, R19
+	ADC ZR, R20, R15
+	MOVD 8(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R4, R19, R4
+	ADC ZR, R20, R15
+	MOVD 16(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R5, R19, R5
+	ADC ZR, R20, R15
+	MOVD 24(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R6, R19, R6
+	ADC ZR, R20, R15
+	MOVD 32(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R7, R19, R7
+	ADC ZR, R20, R15
+	MOVD 40(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R8, R19, R8
+	ADC ZR, R20, R15
+	MOVD 48(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R9, R19, R9
+	ADC ZR, R20, R15
+	MOVD 56(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R10, R19, R10
+	ADC ZR, R20, R15
+	MOVD 64(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R11, R19, R11
+	ADC ZR, R20, R15
+	MOVD 72(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R0, R19, R0
+	ADC ZR, R20, R15
+	MOVD 80(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R1, R19, R1
+	ADC ZR, R20, R15
+	MOVD 88(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R2, R19, R2
+	ADC ZR, R20, R15
+	MOVD 120(R13), R3
+	ADDS R15, R3, R3
+	ADC ZR, ZR, R17
+	ADDS R16, R3, R3
+	ADC ZR, R17, R16
+
+	// | round 4
+	MUL R22, R4, R14
+	MOVD 0(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	CMN R4, R19
+	ADC ZR, R20, R15
+	MOVD 8(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R5, R19, R5
+	ADC ZR, R20, R15
+	MOVD 16(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R6, R19, R6
+	ADC ZR, R20, R15
+	MOVD 24(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R7, R19, R7
+	ADC ZR, R20, R15
+	MOVD 32(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R8, R19, R8
+	ADC ZR, R20, R15
+	MOVD 40(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R9, R19, R9
+	ADC ZR, R20, R15
+	MOVD 48(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R10, R19, R10
+	ADC ZR, R20, R15
+	MOVD 56(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R11, R19, R11
+	ADC ZR, R20, R15
+	MOVD 64(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R0, R19, R0
+	ADC ZR, R20, R15
+	MOVD 72(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R1, R19, R1
+	ADC ZR, R20, R15
+	MOVD 80(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R2, R19, R2
+	ADC ZR, R20, R15
+	MOVD 88(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R3, R19, R3
+	ADC ZR, R20, R15
+	MOVD 128(R13), R4
+	ADDS R15, R4, R4
+	ADC ZR, ZR, R17
+	ADDS R16, R4, R4
+	ADC ZR, R17, R16
+
+	// | round 5
+	MUL R22, R5, R14
+	MOVD 0(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	CMN R5, R19
+	ADC ZR, R20, R15
+	MOVD 8(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R6, R19, R6
+	ADC ZR, R20, R15
+	MOVD 16(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R7, R19, R7
+	ADC ZR, R20, R15
+	MOVD 24(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R8, R19, R8
+	ADC ZR, R20, R15
+	MOVD 32(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R9, R19, R9
+	ADC ZR, R20, R15
+	MOVD 40(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R10, R19, R10
+	ADC ZR, R20, R15
+	MOVD 48(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R11, R19, R11
+	ADC ZR, R20, R15
+	MOVD 56(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R0, R19, R0
+	ADC ZR, R20, R15
+	MOVD 64(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R1, R19, R1
+	ADC ZR, R20, R15
+	MOVD 72(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R2, R19, R2
+	ADC ZR, R20, R15
+	MOVD 80(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R3, R19, R3
+	ADC ZR, R20, R15
+	MOVD 88(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R4, R19, R4
+	ADC ZR, R20, R15
+	MOVD 136(R13), R5
+	ADDS R15, R5, R5
+	ADC ZR, ZR, R17
+	ADDS R16, R5, R5
+	ADC ZR, R17, R16
+
+	// | round 6
+	MUL R22, R6, R14
+	MOVD 0(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	CMN R6, R19
+	ADC ZR, R20, R15
+	MOVD 8(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R7, R19, R7
+	ADC ZR, R20, R15
+	MOVD 16(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R8, R19, R8
+	ADC ZR, R20, R15
+	MOVD 24(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R9, R19, R9
+	ADC ZR, R20, R15
+	MOVD 32(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R10, R19, R10
+	ADC ZR, R20, R15
+	MOVD 40(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R11, R19, R11
+	ADC ZR, R20, R15
+	MOVD 48(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R0, R19, R0
+	ADC ZR, R20, R15
+	MOVD 56(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R1, R19, R1
+	ADC ZR, R20, R15
+	MOVD 64(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R2, R19, R2
+	ADC ZR, R20, R15
+	MOVD 72(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R3, R19, R3
+	ADC ZR, R20, R15
+	MOVD 80(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R4, R19, R4
+	ADC ZR, R20, R15
+	MOVD 88(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R5, R19, R5
+	ADC ZR, R20, R15
+	MOVD 144(R13), R6
+	ADDS R15, R6, R6
+	ADC ZR, ZR, R17
+	ADDS R16, R6, R6
+	ADC ZR, R17, R16
+
+	// | round 7
+	MUL R22, R7, R14
+	MOVD 0(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	CMN R7, R19
+	ADC ZR, R20, R15
+	MOVD 8(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R8, R19, R8
+	ADC ZR, R20, R15
+	MOVD 16(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R9, R19, R9
+	ADC ZR, R20, R15
+	MOVD 24(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R10, R19, R10
+	ADC ZR, R20, R15
+	MOVD 32(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R11, R19, R11
+	ADC ZR, R20, R15
+	MOVD 40(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R0, R19, R0
+	ADC ZR, R20, R15
+	MOVD 48(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R1, R19, R1
+	ADC ZR, R20, R15
+	MOVD 56(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R2, R19, R2
+	ADC ZR, R20, R15
+	MOVD 64(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R3, R19, R3
+	ADC ZR, R20, R15
+	MOVD 72(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R4, R19, R4
+	ADC ZR, R20, R15
+	MOVD 80(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R5, R19, R5
+	ADC ZR, R20, R15
+	MOVD 88(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R6, R19, R6
+	ADC ZR, R20, R15
+	MOVD 152(R13), R7
+	ADDS R15, R7, R7
+	ADC ZR, ZR, R17
+	ADDS R16, R7, R7
+	ADC ZR, R17, R16
+
+	// | round 8
+	MUL R22, R8, R14
+	MOVD 0(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	CMN R8, R19
+	ADC ZR, R20, R15
+	MOVD 8(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R9, R19, R9
+	ADC ZR, R20, R15
+	MOVD 16(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R10, R19, R10
+	ADC ZR, R20, R15
+	MOVD 24(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R11, R19, R11
+	ADC ZR, R20, R15
+	MOVD 32(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R0, R19, R0
+	ADC ZR, R20, R15
+	MOVD 40(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R1, R19, R1
+	ADC ZR, R20, R15
+	MOVD 48(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R2, R19, R2
+	ADC ZR, R20, R15
+	MOVD 56(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R3, R19, R3
+	ADC ZR, R20, R15
+	MOVD 64(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R4, R19, R4
+	ADC ZR, R20, R15
+	MOVD 72(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R5, R19, R5
+	ADC ZR, R20, R15
+	MOVD 80(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R6, R19, R6
+	ADC ZR, R20, R15
+	MOVD 88(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R7, R19, R7
+	ADC ZR, R20, R15
+	MOVD 160(R13), R8
+	ADDS R15, R8, R8
+	ADC ZR, ZR, R17
+	ADDS R16, R8, R8
+	ADC ZR, R17, R16
+
+	// | round 9
+	MUL R22, R9, R14
+	MOVD 0(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	CMN R9, R19
+	ADC ZR, R20, R15
+	MOVD 8(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R10, R19, R10
+	ADC ZR, R20, R15
+	MOVD 16(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R11, R19, R11
+	ADC ZR, R20, R15
+	MOVD 24(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R0, R19, R0
+	ADC ZR, R20, R15
+	MOVD 32(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R1, R19, R1
+	ADC ZR, R20, R15
+	MOVD 40(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R2, R19, R2
+	ADC ZR, R20, R15
+	MOVD 48(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R3, R19, R3
+	ADC ZR, R20, R15
+	MOVD 56(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R4, R19, R4
+	ADC ZR, R20, R15
+	MOVD 64(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R5, R19, R5
+	ADC ZR, R20, R15
+	MOVD 72(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R6, R19, R6
+	ADC ZR, R20, R15
+	MOVD 80(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R7, R19, R7
+	ADC ZR, R20, R15
+	MOVD 88(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R8, R19, R8
+	ADC ZR, R20, R15
+	MOVD 168(R13), R9
+	ADDS R15, R9, R9
+	ADC ZR, ZR, R17
+	ADDS R16, R9, R9
+	ADC ZR, R17, R16
+
+	// | round 10
+	MUL R22, R10, R14
+	MOVD 0(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	CMN R10, R19
+	ADC ZR, R20, R15
+	MOVD 8(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R11, R19, R11
+	ADC ZR, R20, R15
+	MOVD 16(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R0, R19, R0
+	ADC ZR, R20, R15
+	MOVD 24(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R1, R19, R1
+	ADC ZR, R20, R15
+	MOVD 32(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R2, R19, R2
+	ADC ZR, R20, R15
+	MOVD 40(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R3, R19, R3
+	ADC ZR, R20, R15
+	MOVD 48(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R4, R19, R4
+	ADC ZR, R20, R15
+	MOVD 56(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R5, R19, R5
+	ADC ZR, R20, R15
+	MOVD 64(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R6, R19, R6
+	ADC ZR, R20, R15
+	MOVD 72(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R7, R19, R7
+	ADC ZR, R20, R15
+	MOVD 80(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R8, R19, R8
+	ADC ZR, R20, R15
+	MOVD 88(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R9, R19, R9
+	ADC ZR, R20, R15
+	MOVD 176(R13), R10
+	ADDS R15, R10, R10
+	ADC ZR, ZR, R17
+	ADDS R16, R10, R10
+	ADC ZR, R17, R16
+
+	// | round 11
+	MUL R22, R11, R14
+	MOVD 0(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	CMN R11, R19
+	ADC ZR, R20, R15
+	MOVD 8(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R0, R19, R0
+	ADC ZR, R20, R15
+	MOVD 16(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R1, R19, R1
+	ADC ZR, R20, R15
+	MOVD 24(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R2, R19, R2
+	ADC ZR, R20, R15
+	MOVD 32(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R3, R19, R3
+	ADC ZR, R20, R15
+	MOVD 40(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R4, R19, R4
+	ADC ZR, R20, R15
+	MOVD 48(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R5, R19, R5
+	ADC ZR, R20, R15
+	MOVD 56(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R6, R19, R6
+	ADC ZR, R20, R15
+	MOVD 64(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R7, R19, R7
+	ADC ZR, R20, R15
+	MOVD 72(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R8, R19, R8
+	ADC ZR, R20, R15
+	MOVD 80(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R9, R19, R9
+	ADC ZR, R20, R15
+	MOVD 88(R23), R21
+	MUL R21, R14, R19
+	UMULH R21, R14, R20
+	ADDS R15, R19, R19
+	ADC ZR, R20, R20
+	ADDS R10, R19, R10
+	ADC ZR, R20, R15
+	MOVD 184(R13), R11
+	ADDS R15, R11, R11
+	ADC ZR, ZR, R17
+	ADDS R16, R11, R11
+	ADC ZR, R17, R16
+
+	MOVD c+0(FP), R25
+	STP (R0, R1), 0(R25)
+	STP (R2, R3), 16(R25)
+	STP (R4, R5), 32(R25)
+	STP (R6, R7), 48(R25)
+	STP (R8, R9), 64(R25)
+	STP (R10, R11), 80(R25)
+
+	// | t - q
+	MOVD 0(R23), R21
+	SUBS R21, R0, R0
+	MOVD 8(R23), R21
+	SBCS R21, R1, R1
+	MOVD 16(R23), R21
+	SBCS R21, R2, R2
+	MOVD 24(R23), R21
+	SBCS R21, R3, R3
+	MOVD 32(R23), R21
+	SBCS R21, R4, R4
+	MOVD 40(R23), R21
+	SBCS R21, R5, R5
+	MOVD 48(R23), R21
+	SBCS R21, R6, R6
+	MOVD 56(R23), R21
+	SBCS R21, R7, R7
+	MOVD 64(R23), R21
+	SBCS R21, R8, R8
+	MOVD 72(R23), R21
+	SBCS R21, R9, R9
+	MOVD 80(R23), R21
+	SBCS R21, R10, R10
+	MOVD 88(R23), R21
+	SBCS R21, R11, R11
+
+	// | select
+	LDP 0(R25), (R19, R20)
+	CSEL CS, R0, R19, R0
+	CSEL CS, R1, R20, R1
+	LDP 16(R25), (R19, R20)
+	CSEL CS, R2, R19, R2
+	CSEL CS, R3, R20, R3
+	LDP 32(R25), (R19, R20)
+	CSEL CS, R4, R19, R4
+	CSEL CS, R5, R20, R5
+	LDP 48(R25), (R19, R20)
+	CSEL CS, R6, R19, R6
+	CSEL CS, R7, R20, R7
+	LDP 64(R25), (R19, R20)
+	CSEL CS, R8, R19, R8
+	CSEL CS, R9, R20, R9
+	LDP 80(R25), (R19, R20)
+	CSEL CS, R10, R19, R10
+	CSEL CS, R11, R20, R11
+	STP (R0, R1), 0(R25)
+	STP (R2, R3), 16(R25)
+	STP (R4, R5), 32(R25)
+	STP (R6, R7), 48(R25)
+	STP (R8, R9), 64(R25)
+	STP (R10, R11), 80(R25)
+	RET
+/*	 | end													*/
+
+
+// c = a + b
+// no modular reduction is applied
+// func wadd(c *[24]uint64, a *[24]uint64, b *[24]uint64)
+TEXT ·wadd(SB), NOSPLIT, $0-24
+
+	// | lower half
+	MOVD a+8(FP), R25
+	LDP 0(R25), (R0, R1)
+	LDP 16(R25), (R2, R3)
+	LDP 32(R25), (R4, R5)
+	LDP 48(R25), (R6, R7)
+	LDP 64(R25), (R8, R9)
+	LDP 80(R25), (R10, R11)
+	MOVD b+16(FP), R25
+	LDP 0(R25), (R12, R13)
+	LDP 16(R25), (R14, R15)
+	LDP 32(R25), (R16, R17)
+	LDP 48(R25), (R19, R20)
+	LDP 64(R25), (R21, R22)
+	LDP 80(R25), (R23, R24)
+	ADDS R12, R0, R0
+	ADCS R13, R1, R1
+	ADCS R14, R2, R2
+	ADCS R15, R3, R3
+	ADCS R16, R4, R4
+	ADCS R17, R5, R5
+	ADCS R19, R6, R6
+	ADCS R20, R7, R7
+	ADCS R21, R8, R8
+	ADCS R22, R9, R9
+	ADCS R23, R10, R10
+	ADCS R24, R11, R11
+	MOVD c+0(FP), R25
+	STP (R0, R1), 0(R25)
+	STP (R2, R3), 16(R25)
+	STP (R4, R5), 32(R25)
+	STP (R6, R7), 48(R25)
+	STP (R8, R9), 64(R25)
+	STP (R10, R11), 80(R25)
+
+	// | upper half
+	MOVD a+8(FP), R25
+	LDP 96(R25), (R0, R1)
+	LDP 112(R25), (R2, R3)
+	LDP 128(R25), (R4, R5)
+	LDP 144(R25), (R6, R7)
+	LDP 160(R25), (R8, R9)
+	LDP 176(R25), (R10, R11)
+	MOVD b+16(FP), R25
+	LDP 96(R25), (R12, R13)
+	LDP 112(R25), (R14, R15)
+	LDP 128(R25), (R16, R17)
+	LDP 144(R25), (R19, R20)
+	LDP 160(R25), (R21, R22)
+	LDP 176(R25), (R23, R24)
+	ADCS R12, R0, R0
+	ADCS R13, R1, R1
+	ADCS R14, R2, R2
+	ADCS R15, R3, R3
+	ADCS R16, R4, R4
+	ADCS R17, R5, R5
+	ADCS R19, R6, R6
+	ADCS R20, R7, R7
+	ADCS R21, R8, R8
+	ADCS R22, R9, R9
+	ADCS R23, R10, R10
+	ADCS R24, R11, R11
+
+	MOVD c+0(FP), R25
+	STP (R0, R1), 96(R25)
+	STP (R2, R3), 112(R25)
+	STP (R4, R5), 128(R25)
+	STP (R6, R7), 144(R25)
+	STP (R8, R9), 160(R25)
+	STP (R10, R11), 176(R25)
+	RET
+/*	 | end													*/
+
+
+// c = 2 * a
+// no modular reduction is applied
+// func wdouble(c *[24]uint64, a *[24]uint64)
+TEXT ·wdouble(SB), NOSPLIT, $0-16
+
+	// | lower half
+	MOVD a+8(FP), R25
+	LDP 0(R25), (R0, R1)
+	LDP 16(R25), (R2, R3)
+	LDP 32(R25), (R4, R5)
+	LDP 48(R25), (R6, R7)
+	LDP 64(R25), (R8, R9)
+	LDP 80(R25), (R10, R11)
+	ADDS R0, R0, R0
+	ADCS R1, R1, R1
+	ADCS R2, R2, R2
+	ADCS R3, R3, R3
+	ADCS R4, R4, R4
+	ADCS R5, R5, R5
+	ADCS R6, R6, R6
+	ADCS R7, R7, R7
+	ADCS R8, R8, R8
+	ADCS R9, R9, R9
+	ADCS R10, R10, R10
+	ADCS R11, R11, R11
+	MOVD c+0(FP), R25
+	STP (R0, R1), 0(R25)
+	STP (R2, R3), 16(R25)
+	STP (R4, R5), 32(R25)
+	STP (R6, R7), 48(R25)
+	STP (R8, R9), 64(R25)
+	STP (R10, R11), 80(R25)
+
+	// | upper half
+	MOVD a+8(FP), R25
+	LDP 96(R25), (R0, R1)
+	LDP 112(R25), (R2, R3)
+	LDP 128(R25), (R4, R5)
+	LDP 144(R25), (R6, R7)
+	LDP 160(R25), (R8, R9)
+	LDP 176(R25), (R10, R11)
+	ADCS R0, R0, R0
+	ADCS R1, R1, R1
+	ADCS R2, R2, R2
+	ADCS R3, R3, R3
+	ADCS R4, R4, R4
+	ADCS R5, R5, R5
+	ADCS R6, R6, R6
+	ADCS R7, R7, R7
+	ADCS R8, R8, R8
+	ADCS R9, R9, R9
+	ADCS R10, R10, R10
+	ADCS R11, R11, R11
+
+	MOVD c+0(FP), R25
+	STP (R0, R1), 96(R25)
+	STP (R2, R3), 112(R25)
+	STP (R4, R5), 128(R25)
+	STP (R6, R7), 144(R25)
+	STP (R8, R9), 160(R25)
+	STP (R10, R11), 176(R25)
+	RET
+/*	 | end													*/
+
+
+// c = a - b
+// q * 2^768 is added if a < b
+// func wsub(c *[24]uint64, a *[24]uint64, b *[24]uint64)
+TEXT ·wsub(SB), NOSPLIT, $0-24
+
+	// | lower half
+	MOVD a+8(FP), R25
+	LDP 0(R25), (R0, R1)
+	LDP 16(R25), (R2, R3)
+	LDP 32(R25), (R4, R5)
+	LDP 48(R25), (R6, R7)
+	LDP 64(R25), (R8, R9)
+	LDP 80(R25), (R10, R11)
+	MOVD b+16(FP), R25
+	LDP 0(R25), (R12, R13)
+	LDP 16(R25), (R14, R15)
+	LDP 32(R25), (R16, R17)
+	LDP 48(R25), (R19, R20)
+	LDP 64(R25), (R21, R22)
+	LDP 80(R25), (R23, R24)
+	SUBS R12, R0, R0
+	SBCS R13, R1, R1
+	SBCS R14, R2, R2
+	SBCS R15, R3, R3
+	SBCS R16, R4, R4
+	SBCS R17, R5, R5
+	SBCS R19, R6, R6
+	SBCS R20, R7, R7
+	SBCS R21, R8, R8
+	SBCS R22, R9, R9
+	SBCS R23, R10, R10
+	SBCS R24, R11, R11
+	MOVD c+0(FP), R25
+	STP (R0, R1), 0(R25)
+	STP (R2, R3), 16(R25)
+	STP (R4, R5), 32(R25)
+	STP (R6, R7), 48(R25)
+	STP (R8, R9), 64(R25)
+	STP (R10, R11), 80(R25)
+
+	// | upper half
+	MOVD a+8(FP), R25
+	LDP 96(R25), (R0, R1)
+	LDP 112(R25), (R2, R3)
+	LDP 128(R25), (R4, R5)
+	LDP 144(R25), (R6, R7)
+	LDP 160(R25), (R8, R9)
+	LDP 176(R25), (R10, R11)
+	MOVD b+16(FP), R25
+	LDP 96(R25), (R12, R13)
+	LDP 112(R25), (R14, R15)
+	LDP 128(R25), (R16, R17)
+	LDP 144(R25), (R19, R20)
+	LDP 160(R25), (R21, R22)
+	LDP 176(R25), (R23, R24)
+	SBCS R12, R0, R0
+	SBCS R13, R1, R1
+	SBCS R14, R2, R2
+	SBCS R15, R3, R3
+	SBCS R16, R4, R4
+	SBCS R17, R5, R5
+	SBCS R19, R6, R6
+	SBCS R20, R7, R7
+	SBCS R21, R8, R8
+	SBCS R22, R9, R9
+	SBCS R23, R10, R10
+	SBCS R24, R11, R11
+
+	// | add q * 2^768 back if there is a borrow
+	MOVD $·modulus(SB), R25
+	LDP 0(R25), (R12, R13)
+	LDP 16(R25), (R14, R15)
+	LDP 32(R25), (R16, R17)
+	LDP 48(R25), (R19, R20)
+	LDP 64(R25), (R21, R22)
+	LDP 80(R25), (R23, R24)
+	CSEL CS, ZR, R12, R12
+	CSEL CS, ZR, R13, R13
+	CSEL CS, ZR, R14, R14
+	CSEL CS, ZR, R15, R15
+	CSEL CS, ZR, R16, R16
+	CSEL CS, ZR, R17, R17
+	CSEL CS, ZR, R19, R19
+	CSEL CS, ZR, R20, R20
+	CSEL CS, ZR, R21, R21
+	CSEL CS, ZR, R22, R22
+	CSEL CS, ZR, R23, R23
+	CSEL CS, ZR, R24, R24
+	ADDS R12, R0, R0
+	ADCS R13, R1, R1
+	ADCS R14, R2, R2
+	ADCS R15, R3, R3
+	ADCS R16, R4, R4
+	ADCS R17, R5, R5
+	ADCS R19, R6, R6
+	ADCS R20, R7, R7
+	ADCS R21, R8, R8
+	ADCS R22, R9, R9
+	ADCS R23, R10, R10
+	ADCS R24, R11, R11
+
+	MOVD c+0(FP), R25
+	STP (R0, R1), 96(R25)
+	STP (R2, R3), 112(R25)
+	STP (R4, R5), 128(R25)
+	STP (R6, R7), 144(R25)
+	STP (R8, R9), 160(R25)
+	STP (R10, R11), 176(R25)
+	RET
+/*	 | end													*/
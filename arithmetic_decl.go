@@ -1,17 +1,7 @@
-// +build amd64,!generic
+// +build amd64,!generic arm64,!generic
 
 package bw6
 
-import "golang.org/x/sys/cpu"
-
-func init() {
-	if !cpu.X86.HasADX || !cpu.X86.HasBMI2 {
-		mul = mulNoADX
-	}
-}
-
-var mul func(c, a, b *fe) = mulADX
-
 func neg(c, a *fe) {
 	if a.isZero() {
 		c.set(a)
@@ -20,10 +10,6 @@ func neg(c, a *fe) {
 	}
 }
 
-func square(c, a *fe) {
-	mul(c, a, a)
-}
-
 //go:noescape
 func add(c, a, b *fe)
 
@@ -62,9 +48,3 @@ func lsubAssign(a, b *fe)
 
 //go:noescape
 func _neg(c, a *fe)
-
-//go:noescape
-func mulNoADX(c, a, b *fe)
-
-//go:noescape
-func mulADX(c, a, b *fe)
@@ -0,0 +1,27 @@
+// +build arm64,!generic
+
+package bw6
+
+//go:noescape
+func mul(c, a, b *fe)
+
+// square has no dedicated arm64 routine unlike amd64 where squaring shares the off diagonal products.
+// It uses the assembly multiplication instead of squareGeneric which is native go.
+func square(c, a *fe) {
+	mul(c, a, a)
+}
+
+//go:noescape
+func mulWide(c *wfe, a, b *fe)
+
+//go:noescape
+func montRed(c *fe, a *wfe)
+
+//go:noescape
+func wadd(c, a, b *wfe)
+
+//go:noescape
+func wdouble(c, a *wfe)
+
+//go:noescape
+func wsub(c, a, b *wfe)
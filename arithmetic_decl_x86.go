@@ -0,0 +1,88 @@
+// +build amd64,!generic
+
+package bw6
+
+import "golang.org/x/sys/cpu"
+
+func init() {
+	if !cpu.X86.HasADX || !cpu.X86.HasBMI2 {
+		mul = mulNoADX
+		square = squareNoADX
+		mulWide = mulWideNoADX
+		montRed = montRedNoADX
+		mulFR = mulFRNoADX
+	}
+}
+
+var mul func(c, a, b *fe) = mulADX
+
+//go:noescape
+func mulNoADX(c, a, b *fe)
+
+//go:noescape
+func mulADX(c, a, b *fe)
+
+var square func(c, a *fe) = squareADX
+
+//go:noescape
+func squareNoADX(c, a *fe)
+
+//go:noescape
+func squareADX(c, a *fe)
+
+var mulWide func(c *wfe, a, b *fe) = mulWideADX
+
+//go:noescape
+func mulWideNoADX(c *wfe, a, b *fe)
+
+//go:noescape
+func mulWideADX(c *wfe, a, b *fe)
+
+var montRed func(c *fe, a *wfe) = montRedADX
+
+//go:noescape
+func montRedNoADX(c *fe, a *wfe)
+
+//go:noescape
+func montRedADX(c *fe, a *wfe)
+
+//go:noescape
+func wadd(c, a, b *wfe)
+
+//go:noescape
+func wdouble(c, a *wfe)
+
+//go:noescape
+func wsub(c, a, b *wfe)
+
+var mulFR func(c, a, b *Fr) = mulFRADX
+
+func negFR(c, a *Fr) {
+	if a.IsZero() {
+		c.Set(a)
+	} else {
+		_negFR(c, a)
+	}
+}
+
+func squareFR(c, a *Fr) {
+	mulFR(c, a, a)
+}
+
+//go:noescape
+func addFR(c, a, b *Fr)
+
+//go:noescape
+func doubleFR(c, a *Fr)
+
+//go:noescape
+func subFR(c, a, b *Fr)
+
+//go:noescape
+func _negFR(c, a *Fr)
+
+//go:noescape
+func mulFRNoADX(c, a, b *Fr)
+
+//go:noescape
+func mulFRADX(c, a, b *Fr)
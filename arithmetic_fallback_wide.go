@@ -0,0 +1,25 @@
+// +build !amd64,!arm64 generic
+
+package bw6
+
+// Wide arithmetic falls back to native go implementations in arithmetic_generic_wide.go.
+
+func mulWide(z *wfe, x, y *fe) {
+	mulWideGeneric(z, x, y)
+}
+
+func montRed(z *fe, x *wfe) {
+	montRedGeneric(z, x)
+}
+
+func wadd(z, x, y *wfe) {
+	waddGeneric(z, x, y)
+}
+
+func wdouble(z, x *wfe) {
+	wdoubleGeneric(z, x)
+}
+
+func wsub(z, x, y *wfe) {
+	wsubGeneric(z, x, y)
+}
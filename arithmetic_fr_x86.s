@@ -0,0 +1,1545 @@
+// +build amd64,!generic
+
+#include "textflag.h"
+
+
+// c =  (a + b) % q
+// func addFR(c *[6]uint64, a *[6]uint64, b *[6]uint64)
+TEXT ·addFR(SB), NOSPLIT, $0-24
+	// |
+	MOVQ a+8(FP), DI
+	MOVQ b+16(FP), SI
+
+	// |
+	MOVQ (DI), CX
+	MOVQ 8(DI), DX
+	MOVQ 16(DI), R8
+	MOVQ 24(DI), R9
+	MOVQ 32(DI), R10
+	MOVQ 40(DI), R11
+
+	ADDQ (SI), CX
+	ADCQ 8(SI), DX
+	ADCQ 16(SI), R8
+	ADCQ 24(SI), R9
+	ADCQ 32(SI), R10
+	ADCQ 40(SI), R11
+
+	// |
+	MOVQ CX, R12
+	MOVQ DX, R13
+	MOVQ R8, R14
+	MOVQ R9, R15
+	MOVQ R10, AX
+	MOVQ R11, BX
+	SUBQ ·frModulus+0(SB), R12
+	SBBQ ·frModulus+8(SB), R13
+	SBBQ ·frModulus+16(SB), R14
+	SBBQ ·frModulus+24(SB), R15
+	SBBQ ·frModulus+32(SB), AX
+	SBBQ ·frModulus+40(SB), BX
+
+	// |
+	CMOVQCC R12, CX
+	CMOVQCC R13, DX
+	CMOVQCC R14, R8
+	CMOVQCC R15, R9
+	CMOVQCC AX, R10
+	CMOVQCC BX, R11
+
+	MOVQ c+0(FP), DI
+	MOVQ CX, (DI)
+	MOVQ DX, 8(DI)
+	MOVQ R8, 16(DI)
+	MOVQ R9, 24(DI)
+	MOVQ R10, 32(DI)
+	MOVQ R11, 40(DI)
+	RET
+/*	 | end													*/
+
+
+// c =  (2 * a) % q
+// func doubleFR(c *[6]uint64, a *[6]uint64)
+TEXT ·doubleFR(SB), NOSPLIT, $0-16
+	// |
+	MOVQ a+8(FP), DI
+
+	// |
+	MOVQ (DI), CX
+	MOVQ 8(DI), DX
+	MOVQ 16(DI), R8
+	MOVQ 24(DI), R9
+	MOVQ 32(DI), R10
+	MOVQ 40(DI), R11
+
+	ADDQ CX, CX
+	ADCQ DX, DX
+	ADCQ R8, R8
+	ADCQ R9, R9
+	ADCQ R10, R10
+	ADCQ R11, R11
+
+	// |
+	MOVQ CX, R12
+	MOVQ DX, R13
+	MOVQ R8, R14
+	MOVQ R9, R15
+	MOVQ R10, AX
+	MOVQ R11, BX
+	SUBQ ·frModulus+0(SB), R12
+	SBBQ ·frModulus+8(SB), R13
+	SBBQ ·frModulus+16(SB), R14
+	SBBQ ·frModulus+24(SB), R15
+	SBBQ ·frModulus+32(SB), AX
+	SBBQ ·frModulus+40(SB), BX
+
+	// |
+	CMOVQCC R12, CX
+	CMOVQCC R13, DX
+	CMOVQCC R14, R8
+	CMOVQCC R15, R9
+	CMOVQCC AX, R10
+	CMOVQCC BX, R11
+
+	MOVQ c+0(FP), DI
+	MOVQ CX, (DI)
+	MOVQ DX, 8(DI)
+	MOVQ R8, 16(DI)
+	MOVQ R9, 24(DI)
+	MOVQ R10, 32(DI)
+	MOVQ R11, 40(DI)
+	RET
+/*	 | end													*/
+
+
+// c =  (a - b) % q
+// func subFR(c *[6]uint64, a *[6]uint64, b *[6]uint64)
+TEXT ·subFR(SB), NOSPLIT, $0-24
+	// |
+	MOVQ a+8(FP), DI
+	MOVQ b+16(FP), SI
+
+	// |
+	MOVQ (DI), CX
+	MOVQ 8(DI), DX
+	MOVQ 16(DI), R8
+	MOVQ 24(DI), R9
+	MOVQ 32(DI), R10
+	MOVQ 40(DI), R11
+
+	SUBQ (SI), CX
+	SBBQ 8(SI), DX
+	SBBQ 16(SI), R8
+	SBBQ 24(SI), R9
+	SBBQ 32(SI), R10
+	SBBQ 40(SI), R11
+
+	// | prepare modulus or zero
+	MOVQ $0x00, R12
+	MOVQ $0x00, R13
+	MOVQ $0x00, R14
+	MOVQ $0x00, R15
+	MOVQ $0x00, AX
+	MOVQ $0x00, BX
+	CMOVQCS ·frModulus+0(SB), R12
+	CMOVQCS ·frModulus+8(SB), R13
+	CMOVQCS ·frModulus+16(SB), R14
+	CMOVQCS ·frModulus+24(SB), R15
+	CMOVQCS ·frModulus+32(SB), AX
+	CMOVQCS ·frModulus+40(SB), BX
+
+	// |
+	ADDQ R12, CX
+	ADCQ R13, DX
+	ADCQ R14, R8
+	ADCQ R15, R9
+	ADCQ AX, R10
+	ADCQ BX, R11
+
+	MOVQ c+0(FP), DI
+	MOVQ CX, (DI)
+	MOVQ DX, 8(DI)
+	MOVQ R8, 16(DI)
+	MOVQ R9, 24(DI)
+	MOVQ R10, 32(DI)
+	MOVQ R11, 40(DI)
+	RET
+/*	 | end													*/
+
+
+// c = -a
+// func _negFR(c *[6]uint64, a *[6]uint64)
+TEXT ·_negFR(SB), NOSPLIT, $0-16
+	// |
+	MOVQ a+8(FP), DI
+
+	// |
+	MOVQ ·frModulus+0(SB), CX
+	MOVQ ·frModulus+8(SB), DX
+	MOVQ ·frModulus+16(SB), R8
+	MOVQ ·frModulus+24(SB), R9
+	MOVQ ·frModulus+32(SB), R10
+	MOVQ ·frModulus+40(SB), R11
+
+	SUBQ (DI), CX
+	SBBQ 8(DI), DX
+	SBBQ 16(DI), R8
+	SBBQ 24(DI), R9
+	SBBQ 32(DI), R10
+	SBBQ 40(DI), R11
+
+	// |
+	MOVQ c+0(FP), DI
+	MOVQ CX, (DI)
+	MOVQ DX, 8(DI)
+	MOVQ R8, 16(DI)
+	MOVQ R9, 24(DI)
+	MOVQ R10, 32(DI)
+	MOVQ R11, 40(DI)
+	RET
+/*	 | end													*/
+
+
+// func mulFRNoADX(c *[6]uint64, a *[6]uint64, b *[6]uint64)
+TEXT ·mulFRNoADX(SB), NOSPLIT, $0-24
+	// | 
+
+/* inputs                                  */
+
+	MOVQ a+8(FP), DI
+	MOVQ b+16(FP), SI
+	// | 
+
+/* i = 0                                   */
+
+	// | clear accumulator
+	MOVQ $0x00, CX
+	MOVQ $0x00, R8
+	MOVQ $0x00, R9
+	MOVQ $0x00, R10
+	MOVQ $0x00, R11
+	MOVQ $0x00, R12
+	MOVQ $0x00, R13
+
+	// | a0 @ R14
+	MOVQ (DI), R14
+	MOVQ $0x00, BX
+
+	// | a0 * b0 
+	MOVQ (SI), AX
+	MULQ R14
+	ADDQ AX, CX
+	ADCQ $0x00, DX
+	ADDQ BX, CX
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | a0 * b1 
+	MOVQ 8(SI), AX
+	MULQ R14
+	ADDQ AX, R8
+	ADCQ $0x00, DX
+	ADDQ BX, R8
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | a0 * b2 
+	MOVQ 16(SI), AX
+	MULQ R14
+	ADDQ AX, R9
+	ADCQ $0x00, DX
+	ADDQ BX, R9
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | a0 * b3 
+	MOVQ 24(SI), AX
+	MULQ R14
+	ADDQ AX, R10
+	ADCQ $0x00, DX
+	ADDQ BX, R10
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | a0 * b4 
+	MOVQ 32(SI), AX
+	MULQ R14
+	ADDQ AX, R11
+	ADCQ $0x00, DX
+	ADDQ BX, R11
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | a0 * b5 
+	MOVQ 40(SI), AX
+	MULQ R14
+	ADDQ AX, R12
+	ADCQ $0x00, DX
+	ADDQ BX, R12
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	ADDQ BX, R13
+
+	// | u0 = w0 * inp
+	MOVQ CX, AX
+	MULQ ·frInp+0(SB)
+	MOVQ AX, R15
+	MOVQ $0x00, BX
+
+	// | u0 * q0 
+	MOVQ ·frModulus+0(SB), AX
+	MULQ R15
+	ADDQ AX, CX
+	ADCQ $0x00, DX
+	ADDQ BX, CX
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | u0 * q1 
+	MOVQ ·frModulus+8(SB), AX
+	MULQ R15
+	ADDQ AX, R8
+	ADCQ $0x00, DX
+	ADDQ BX, R8
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | u0 * q2 
+	MOVQ ·frModulus+16(SB), AX
+	MULQ R15
+	ADDQ AX, R9
+	ADCQ $0x00, DX
+	ADDQ BX, R9
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | u0 * q3 
+	MOVQ ·frModulus+24(SB), AX
+	MULQ R15
+	ADDQ AX, R10
+	ADCQ $0x00, DX
+	ADDQ BX, R10
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | u0 * q4 
+	MOVQ ·frModulus+32(SB), AX
+	MULQ R15
+	ADDQ AX, R11
+	ADCQ $0x00, DX
+	ADDQ BX, R11
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | u0 * q5 
+	MOVQ ·frModulus+40(SB), AX
+	MULQ R15
+	ADDQ AX, R12
+	ADCQ $0x00, DX
+	ADDQ BX, R12
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	ADDQ BX, R13
+
+	// | 
+
+/* i = 1                                   */
+
+	// | a1 @ R14
+	MOVQ 8(DI), R14
+	MOVQ $0x00, BX
+
+	// | a1 * b0 
+	MOVQ (SI), AX
+	MULQ R14
+	ADDQ AX, R8
+	ADCQ $0x00, DX
+	ADDQ BX, R8
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | a1 * b1 
+	MOVQ 8(SI), AX
+	MULQ R14
+	ADDQ AX, R9
+	ADCQ $0x00, DX
+	ADDQ BX, R9
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | a1 * b2 
+	MOVQ 16(SI), AX
+	MULQ R14
+	ADDQ AX, R10
+	ADCQ $0x00, DX
+	ADDQ BX, R10
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | a1 * b3 
+	MOVQ 24(SI), AX
+	MULQ R14
+	ADDQ AX, R11
+	ADCQ $0x00, DX
+	ADDQ BX, R11
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | a1 * b4 
+	MOVQ 32(SI), AX
+	MULQ R14
+	ADDQ AX, R12
+	ADCQ $0x00, DX
+	ADDQ BX, R12
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | a1 * b5 
+	MOVQ 40(SI), AX
+	MULQ R14
+	ADDQ AX, R13
+	ADCQ $0x00, DX
+	ADDQ BX, R13
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	ADDQ BX, CX
+
+	// | u1 = w0 * inp
+	MOVQ R8, AX
+	MULQ ·frInp+0(SB)
+	MOVQ AX, R15
+	MOVQ $0x00, BX
+
+	// | u1 * q0 
+	MOVQ ·frModulus+0(SB), AX
+	MULQ R15
+	ADDQ AX, R8
+	ADCQ $0x00, DX
+	ADDQ BX, R8
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | u1 * q1 
+	MOVQ ·frModulus+8(SB), AX
+	MULQ R15
+	ADDQ AX, R9
+	ADCQ $0x00, DX
+	ADDQ BX, R9
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | u1 * q2 
+	MOVQ ·frModulus+16(SB), AX
+	MULQ R15
+	ADDQ AX, R10
+	ADCQ $0x00, DX
+	ADDQ BX, R10
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | u1 * q3 
+	MOVQ ·frModulus+24(SB), AX
+	MULQ R15
+	ADDQ AX, R11
+	ADCQ $0x00, DX
+	ADDQ BX, R11
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | u1 * q4 
+	MOVQ ·frModulus+32(SB), AX
+	MULQ R15
+	ADDQ AX, R12
+	ADCQ $0x00, DX
+	ADDQ BX, R12
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | u1 * q5 
+	MOVQ ·frModulus+40(SB), AX
+	MULQ R15
+	ADDQ AX, R13
+	ADCQ $0x00, DX
+	ADDQ BX, R13
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	ADDQ BX, CX
+
+	// | 
+
+/* i = 2                                   */
+
+	// | a2 @ R14
+	MOVQ 16(DI), R14
+	MOVQ $0x00, BX
+
+	// | a2 * b0 
+	MOVQ (SI), AX
+	MULQ R14
+	ADDQ AX, R9
+	ADCQ $0x00, DX
+	ADDQ BX, R9
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | a2 * b1 
+	MOVQ 8(SI), AX
+	MULQ R14
+	ADDQ AX, R10
+	ADCQ $0x00, DX
+	ADDQ BX, R10
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | a2 * b2 
+	MOVQ 16(SI), AX
+	MULQ R14
+	ADDQ AX, R11
+	ADCQ $0x00, DX
+	ADDQ BX, R11
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | a2 * b3 
+	MOVQ 24(SI), AX
+	MULQ R14
+	ADDQ AX, R12
+	ADCQ $0x00, DX
+	ADDQ BX, R12
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | a2 * b4 
+	MOVQ 32(SI), AX
+	MULQ R14
+	ADDQ AX, R13
+	ADCQ $0x00, DX
+	ADDQ BX, R13
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | a2 * b5 
+	MOVQ 40(SI), AX
+	MULQ R14
+	ADDQ AX, CX
+	ADCQ $0x00, DX
+	ADDQ BX, CX
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	ADDQ BX, R8
+
+	// | u2 = w0 * inp
+	MOVQ R9, AX
+	MULQ ·frInp+0(SB)
+	MOVQ AX, R15
+	MOVQ $0x00, BX
+
+	// | u2 * q0 
+	MOVQ ·frModulus+0(SB), AX
+	MULQ R15
+	ADDQ AX, R9
+	ADCQ $0x00, DX
+	ADDQ BX, R9
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | u2 * q1 
+	MOVQ ·frModulus+8(SB), AX
+	MULQ R15
+	ADDQ AX, R10
+	ADCQ $0x00, DX
+	ADDQ BX, R10
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | u2 * q2 
+	MOVQ ·frModulus+16(SB), AX
+	MULQ R15
+	ADDQ AX, R11
+	ADCQ $0x00, DX
+	ADDQ BX, R11
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | u2 * q3 
+	MOVQ ·frModulus+24(SB), AX
+	MULQ R15
+	ADDQ AX, R12
+	ADCQ $0x00, DX
+	ADDQ BX, R12
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | u2 * q4 
+	MOVQ ·frModulus+32(SB), AX
+	MULQ R15
+	ADDQ AX, R13
+	ADCQ $0x00, DX
+	ADDQ BX, R13
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | u2 * q5 
+	MOVQ ·frModulus+40(SB), AX
+	MULQ R15
+	ADDQ AX, CX
+	ADCQ $0x00, DX
+	ADDQ BX, CX
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	ADDQ BX, R8
+
+	// | 
+
+/* i = 3                                   */
+
+	// | a3 @ R14
+	MOVQ 24(DI), R14
+	MOVQ $0x00, BX
+
+	// | a3 * b0 
+	MOVQ (SI), AX
+	MULQ R14
+	ADDQ AX, R10
+	ADCQ $0x00, DX
+	ADDQ BX, R10
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | a3 * b1 
+	MOVQ 8(SI), AX
+	MULQ R14
+	ADDQ AX, R11
+	ADCQ $0x00, DX
+	ADDQ BX, R11
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | a3 * b2 
+	MOVQ 16(SI), AX
+	MULQ R14
+	ADDQ AX, R12
+	ADCQ $0x00, DX
+	ADDQ BX, R12
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | a3 * b3 
+	MOVQ 24(SI), AX
+	MULQ R14
+	ADDQ AX, R13
+	ADCQ $0x00, DX
+	ADDQ BX, R13
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | a3 * b4 
+	MOVQ 32(SI), AX
+	MULQ R14
+	ADDQ AX, CX
+	ADCQ $0x00, DX
+	ADDQ BX, CX
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | a3 * b5 
+	MOVQ 40(SI), AX
+	MULQ R14
+	ADDQ AX, R8
+	ADCQ $0x00, DX
+	ADDQ BX, R8
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	ADDQ BX, R9
+
+	// | u3 = w0 * inp
+	MOVQ R10, AX
+	MULQ ·frInp+0(SB)
+	MOVQ AX, R15
+	MOVQ $0x00, BX
+
+	// | u3 * q0 
+	MOVQ ·frModulus+0(SB), AX
+	MULQ R15
+	ADDQ AX, R10
+	ADCQ $0x00, DX
+	ADDQ BX, R10
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | u3 * q1 
+	MOVQ ·frModulus+8(SB), AX
+	MULQ R15
+	ADDQ AX, R11
+	ADCQ $0x00, DX
+	ADDQ BX, R11
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | u3 * q2 
+	MOVQ ·frModulus+16(SB), AX
+	MULQ R15
+	ADDQ AX, R12
+	ADCQ $0x00, DX
+	ADDQ BX, R12
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | u3 * q3 
+	MOVQ ·frModulus+24(SB), AX
+	MULQ R15
+	ADDQ AX, R13
+	ADCQ $0x00, DX
+	ADDQ BX, R13
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | u3 * q4 
+	MOVQ ·frModulus+32(SB), AX
+	MULQ R15
+	ADDQ AX, CX
+	ADCQ $0x00, DX
+	ADDQ BX, CX
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | u3 * q5 
+	MOVQ ·frModulus+40(SB), AX
+	MULQ R15
+	ADDQ AX, R8
+	ADCQ $0x00, DX
+	ADDQ BX, R8
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	ADDQ BX, R9
+
+	// | 
+
+/* i = 4                                   */
+
+	// | a4 @ R14
+	MOVQ 32(DI), R14
+	MOVQ $0x00, BX
+
+	// | a4 * b0 
+	MOVQ (SI), AX
+	MULQ R14
+	ADDQ AX, R11
+	ADCQ $0x00, DX
+	ADDQ BX, R11
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | a4 * b1 
+	MOVQ 8(SI), AX
+	MULQ R14
+	ADDQ AX, R12
+	ADCQ $0x00, DX
+	ADDQ BX, R12
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | a4 * b2 
+	MOVQ 16(SI), AX
+	MULQ R14
+	ADDQ AX, R13
+	ADCQ $0x00, DX
+	ADDQ BX, R13
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | a4 * b3 
+	MOVQ 24(SI), AX
+	MULQ R14
+	ADDQ AX, CX
+	ADCQ $0x00, DX
+	ADDQ BX, CX
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | a4 * b4 
+	MOVQ 32(SI), AX
+	MULQ R14
+	ADDQ AX, R8
+	ADCQ $0x00, DX
+	ADDQ BX, R8
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | a4 * b5 
+	MOVQ 40(SI), AX
+	MULQ R14
+	ADDQ AX, R9
+	ADCQ $0x00, DX
+	ADDQ BX, R9
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	ADDQ BX, R10
+
+	// | u4 = w0 * inp
+	MOVQ R11, AX
+	MULQ ·frInp+0(SB)
+	MOVQ AX, R15
+	MOVQ $0x00, BX
+
+	// | u4 * q0 
+	MOVQ ·frModulus+0(SB), AX
+	MULQ R15
+	ADDQ AX, R11
+	ADCQ $0x00, DX
+	ADDQ BX, R11
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | u4 * q1 
+	MOVQ ·frModulus+8(SB), AX
+	MULQ R15
+	ADDQ AX, R12
+	ADCQ $0x00, DX
+	ADDQ BX, R12
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | u4 * q2 
+	MOVQ ·frModulus+16(SB), AX
+	MULQ R15
+	ADDQ AX, R13
+	ADCQ $0x00, DX
+	ADDQ BX, R13
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | u4 * q3 
+	MOVQ ·frModulus+24(SB), AX
+	MULQ R15
+	ADDQ AX, CX
+	ADCQ $0x00, DX
+	ADDQ BX, CX
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | u4 * q4 
+	MOVQ ·frModulus+32(SB), AX
+	MULQ R15
+	ADDQ AX, R8
+	ADCQ $0x00, DX
+	ADDQ BX, R8
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | u4 * q5 
+	MOVQ ·frModulus+40(SB), AX
+	MULQ R15
+	ADDQ AX, R9
+	ADCQ $0x00, DX
+	ADDQ BX, R9
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	ADDQ BX, R10
+
+	// | 
+
+/* i = 5                                   */
+
+	// | a5 @ R14
+	MOVQ 40(DI), R14
+	MOVQ $0x00, BX
+
+	// | a5 * b0 
+	MOVQ (SI), AX
+	MULQ R14
+	ADDQ AX, R12
+	ADCQ $0x00, DX
+	ADDQ BX, R12
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | a5 * b1 
+	MOVQ 8(SI), AX
+	MULQ R14
+	ADDQ AX, R13
+	ADCQ $0x00, DX
+	ADDQ BX, R13
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | a5 * b2 
+	MOVQ 16(SI), AX
+	MULQ R14
+	ADDQ AX, CX
+	ADCQ $0x00, DX
+	ADDQ BX, CX
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | a5 * b3 
+	MOVQ 24(SI), AX
+	MULQ R14
+	ADDQ AX, R8
+	ADCQ $0x00, DX
+	ADDQ BX, R8
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | a5 * b4 
+	MOVQ 32(SI), AX
+	MULQ R14
+	ADDQ AX, R9
+	ADCQ $0x00, DX
+	ADDQ BX, R9
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | a5 * b5 
+	MOVQ 40(SI), AX
+	MULQ R14
+	ADDQ AX, R10
+	ADCQ $0x00, DX
+	ADDQ BX, R10
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	ADDQ BX, R11
+
+	// | u5 = w0 * inp
+	MOVQ R12, AX
+	MULQ ·frInp+0(SB)
+	MOVQ AX, R15
+	MOVQ $0x00, BX
+
+	// | u5 * q0 
+	MOVQ ·frModulus+0(SB), AX
+	MULQ R15
+	ADDQ AX, R12
+	ADCQ $0x00, DX
+	ADDQ BX, R12
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | u5 * q1 
+	MOVQ ·frModulus+8(SB), AX
+	MULQ R15
+	ADDQ AX, R13
+	ADCQ $0x00, DX
+	ADDQ BX, R13
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | u5 * q2 
+	MOVQ ·frModulus+16(SB), AX
+	MULQ R15
+	ADDQ AX, CX
+	ADCQ $0x00, DX
+	ADDQ BX, CX
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | u5 * q3 
+	MOVQ ·frModulus+24(SB), AX
+	MULQ R15
+	ADDQ AX, R8
+	ADCQ $0x00, DX
+	ADDQ BX, R8
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | u5 * q4 
+	MOVQ ·frModulus+32(SB), AX
+	MULQ R15
+	ADDQ AX, R9
+	ADCQ $0x00, DX
+	ADDQ BX, R9
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	// | u5 * q5 
+	MOVQ ·frModulus+40(SB), AX
+	MULQ R15
+	ADDQ AX, R10
+	ADCQ $0x00, DX
+	ADDQ BX, R10
+	ADCQ $0x00, DX
+	MOVQ DX, BX
+
+	ADDQ BX, R11
+
+	// | 
+
+/* modular reduction                       */
+
+	MOVQ R13, AX
+	SUBQ ·frModulus+0(SB), AX
+	MOVQ CX, BX
+	SBBQ ·frModulus+8(SB), BX
+	MOVQ R8, DX
+	SBBQ ·frModulus+16(SB), DX
+	MOVQ R9, SI
+	SBBQ ·frModulus+24(SB), SI
+	MOVQ R10, DI
+	SBBQ ·frModulus+32(SB), DI
+	MOVQ R11, R14
+	SBBQ ·frModulus+40(SB), R14
+
+	// |
+	CMOVQCC AX, R13
+	CMOVQCC BX, CX
+	CMOVQCC DX, R8
+	CMOVQCC SI, R9
+	CMOVQCC DI, R10
+	CMOVQCC R14, R11
+
+	// | 
+
+/* out                                     */
+
+	MOVQ c+0(FP), DI
+	MOVQ R13, (DI)
+	MOVQ CX, 8(DI)
+	MOVQ R8, 16(DI)
+	MOVQ R9, 24(DI)
+	MOVQ R10, 32(DI)
+	MOVQ R11, 40(DI)
+	RET
+/*	 | end													*/
+
+
+// func mulFRADX(c *[6]uint64, a *[6]uint64, b *[6]uint64)
+TEXT ·mulFRADX(SB), NOSPLIT, $0-24
+	// | 
+
+/* inputs                                  */
+
+	MOVQ a+8(FP), DI
+	MOVQ b+16(FP), SI
+	// | 
+
+/* i = 0                                   */
+
+	// | clear accumulator
+	MOVQ $0x00, CX
+	MOVQ $0x00, R8
+	MOVQ $0x00, R9
+	MOVQ $0x00, R10
+	MOVQ $0x00, R11
+	MOVQ $0x00, R12
+	MOVQ $0x00, R13
+
+	// | a0 @ DX
+	MOVQ (DI), DX
+	XORQ AX, AX
+
+	// | a0 * b0 
+	MULXQ (SI), AX, BX
+	ADOXQ AX, CX
+	ADCXQ BX, R8
+
+	// | a0 * b1 
+	MULXQ 8(SI), AX, BX
+	ADOXQ AX, R8
+	ADCXQ BX, R9
+
+	// | a0 * b2 
+	MULXQ 16(SI), AX, BX
+	ADOXQ AX, R9
+	ADCXQ BX, R10
+
+	// | a0 * b3 
+	MULXQ 24(SI), AX, BX
+	ADOXQ AX, R10
+	ADCXQ BX, R11
+
+	// | a0 * b4 
+	MULXQ 32(SI), AX, BX
+	ADOXQ AX, R11
+	ADCXQ BX, R12
+
+	// | a0 * b5 
+	MULXQ 40(SI), AX, BX
+	ADOXQ AX, R12
+	ADCXQ BX, R13
+
+	MOVQ  $0x00, AX
+	ADOXQ AX, R13
+
+	// | u0 = w0 * inp
+	MOVQ  CX, DX
+	MULXQ ·frInp+0(SB), DX, BX
+	XORQ  AX, AX
+
+	// | u0 * q0 
+	MULXQ ·frModulus+0(SB), AX, BX
+	ADOXQ AX, CX
+	ADCXQ BX, R8
+
+	// | u0 * q1 
+	MULXQ ·frModulus+8(SB), AX, BX
+	ADOXQ AX, R8
+	ADCXQ BX, R9
+
+	// | u0 * q2 
+	MULXQ ·frModulus+16(SB), AX, BX
+	ADOXQ AX, R9
+	ADCXQ BX, R10
+
+	// | u0 * q3 
+	MULXQ ·frModulus+24(SB), AX, BX
+	ADOXQ AX, R10
+	ADCXQ BX, R11
+
+	// | u0 * q4 
+	MULXQ ·frModulus+32(SB), AX, BX
+	ADOXQ AX, R11
+	ADCXQ BX, R12
+
+	// | u0 * q5 
+	MULXQ ·frModulus+40(SB), AX, BX
+	ADOXQ AX, R12
+	ADCXQ BX, R13
+
+	MOVQ  $0x00, AX
+	ADOXQ AX, R13
+
+	// | 
+
+/* i = 1                                   */
+
+	// | a1 @ DX
+	MOVQ 8(DI), DX
+	XORQ AX, AX
+
+	// | a1 * b0 
+	MULXQ (SI), AX, BX
+	ADOXQ AX, R8
+	ADCXQ BX, R9
+
+	// | a1 * b1 
+	MULXQ 8(SI), AX, BX
+	ADOXQ AX, R9
+	ADCXQ BX, R10
+
+	// | a1 * b2 
+	MULXQ 16(SI), AX, BX
+	ADOXQ AX, R10
+	ADCXQ BX, R11
+
+	// | a1 * b3 
+	MULXQ 24(SI), AX, BX
+	ADOXQ AX, R11
+	ADCXQ BX, R12
+
+	// | a1 * b4 
+	MULXQ 32(SI), AX, BX
+	ADOXQ AX, R12
+	ADCXQ BX, R13
+
+	// | a1 * b5 
+	MULXQ 40(SI), AX, BX
+	ADOXQ AX, R13
+	ADCXQ BX, CX
+
+	MOVQ  $0x00, AX
+	ADOXQ AX, CX
+
+	// | u1 = w0 * inp
+	MOVQ  R8, DX
+	MULXQ ·frInp+0(SB), DX, BX
+	XORQ  AX, AX
+
+	// | u1 * q0 
+	MULXQ ·frModulus+0(SB), AX, BX
+	ADOXQ AX, R8
+	ADCXQ BX, R9
+
+	// | u1 * q1 
+	MULXQ ·frModulus+8(SB), AX, BX
+	ADOXQ AX, R9
+	ADCXQ BX, R10
+
+	// | u1 * q2 
+	MULXQ ·frModulus+16(SB), AX, BX
+	ADOXQ AX, R10
+	ADCXQ BX, R11
+
+	// | u1 * q3 
+	MULXQ ·frModulus+24(SB), AX, BX
+	ADOXQ AX, R11
+	ADCXQ BX, R12
+
+	// | u1 * q4 
+	MULXQ ·frModulus+32(SB), AX, BX
+	ADOXQ AX, R12
+	ADCXQ BX, R13
+
+	// | u1 * q5 
+	MULXQ ·frModulus+40(SB), AX, BX
+	ADOXQ AX, R13
+	ADCXQ BX, CX
+
+	MOVQ  $0x00, AX
+	ADOXQ AX, CX
+
+	// | 
+
+/* i = 2                                   */
+
+	// | a2 @ DX
+	MOVQ 16(DI), DX
+	XORQ AX, AX
+
+	// | a2 * b0 
+	MULXQ (SI), AX, BX
+	ADOXQ AX, R9
+	ADCXQ BX, R10
+
+	// | a2 * b1 
+	MULXQ 8(SI), AX, BX
+	ADOXQ AX, R10
+	ADCXQ BX, R11
+
+	// | a2 * b2 
+	MULXQ 16(SI), AX, BX
+	ADOXQ AX, R11
+	ADCXQ BX, R12
+
+	// | a2 * b3 
+	MULXQ 24(SI), AX, BX
+	ADOXQ AX, R12
+	ADCXQ BX, R13
+
+	// | a2 * b4 
+	MULXQ 32(SI), AX, BX
+	ADOXQ AX, R13
+	ADCXQ BX, CX
+
+	// | a2 * b5 
+	MULXQ 40(SI), AX, BX
+	ADOXQ AX, CX
+	ADCXQ BX, R8
+
+	MOVQ  $0x00, AX
+	ADOXQ AX, R8
+
+	// | u2 = w0 * inp
+	MOVQ  R9, DX
+	MULXQ ·frInp+0(SB), DX, BX
+	XORQ  AX, AX
+
+	// | u2 * q0 
+	MULXQ ·frModulus+0(SB), AX, BX
+	ADOXQ AX, R9
+	ADCXQ BX, R10
+
+	// | u2 * q1 
+	MULXQ ·frModulus+8(SB), AX, BX
+	ADOXQ AX, R10
+	ADCXQ BX, R11
+
+	// | u2 * q2 
+	MULXQ ·frModulus+16(SB), AX, BX
+	ADOXQ AX, R11
+	ADCXQ BX, R12
+
+	// | u2 * q3 
+	MULXQ ·frModulus+24(SB), AX, BX
+	ADOXQ AX, R12
+	ADCXQ BX, R13
+
+	// | u2 * q4 
+	MULXQ ·frModulus+32(SB), AX, BX
+	ADOXQ AX, R13
+	ADCXQ BX, CX
+
+	// | u2 * q5 
+	MULXQ ·frModulus+40(SB), AX, BX
+	ADOXQ AX, CX
+	ADCXQ BX, R8
+
+	MOVQ  $0x00, AX
+	ADOXQ AX, R8
+
+	// | 
+
+/* i = 3                                   */
+
+	// | a3 @ DX
+	MOVQ 24(DI), DX
+	XORQ AX, AX
+
+	// | a3 * b0 
+	MULXQ (SI), AX, BX
+	ADOXQ AX, R10
+	ADCXQ BX, R11
+
+	// | a3 * b1 
+	MULXQ 8(SI), AX, BX
+	ADOXQ AX, R11
+	ADCXQ BX, R12
+
+	// | a3 * b2 
+	MULXQ 16(SI), AX, BX
+	ADOXQ AX, R12
+	ADCXQ BX, R13
+
+	// | a3 * b3 
+	MULXQ 24(SI), AX, BX
+	ADOXQ AX, R13
+	ADCXQ BX, CX
+
+	// | a3 * b4 
+	MULXQ 32(SI), AX, BX
+	ADOXQ AX, CX
+	ADCXQ BX, R8
+
+	// | a3 * b5 
+	MULXQ 40(SI), AX, BX
+	ADOXQ AX, R8
+	ADCXQ BX, R9
+
+	MOVQ  $0x00, AX
+	ADOXQ AX, R9
+
+	// | u3 = w0 * inp
+	MOVQ  R10, DX
+	MULXQ ·frInp+0(SB), DX, BX
+	XORQ  AX, AX
+
+	// | u3 * q0 
+	MULXQ ·frModulus+0(SB), AX, BX
+	ADOXQ AX, R10
+	ADCXQ BX, R11
+
+	// | u3 * q1 
+	MULXQ ·frModulus+8(SB), AX, BX
+	ADOXQ AX, R11
+	ADCXQ BX, R12
+
+	// | u3 * q2 
+	MULXQ ·frModulus+16(SB), AX, BX
+	ADOXQ AX, R12
+	ADCXQ BX, R13
+
+	// | u3 * q3 
+	MULXQ ·frModulus+24(SB), AX, BX
+	ADOXQ AX, R13
+	ADCXQ BX, CX
+
+	// | u3 * q4 
+	MULXQ ·frModulus+32(SB), AX, BX
+	ADOXQ AX, CX
+	ADCXQ BX, R8
+
+	// | u3 * q5 
+	MULXQ ·frModulus+40(SB), AX, BX
+	ADOXQ AX, R8
+	ADCXQ BX, R9
+
+	MOVQ  $0x00, AX
+	ADOXQ AX, R9
+
+	// | 
+
+/* i = 4                                   */
+
+	// | a4 @ DX
+	MOVQ 32(DI), DX
+	XORQ AX, AX
+
+	// | a4 * b0 
+	MULXQ (SI), AX, BX
+	ADOXQ AX, R11
+	ADCXQ BX, R12
+
+	// | a4 * b1 
+	MULXQ 8(SI), AX, BX
+	ADOXQ AX, R12
+	ADCXQ BX, R13
+
+	// | a4 * b2 
+	MULXQ 16(SI), AX, BX
+	ADOXQ AX, R13
+	ADCXQ BX, CX
+
+	// | a4 * b3 
+	MULXQ 24(SI), AX, BX
+	ADOXQ AX, CX
+	ADCXQ BX, R8
+
+	// | a4 * b4 
+	MULXQ 32(SI), AX, BX
+	ADOXQ AX, R8
+	ADCXQ BX, R9
+
+	// | a4 * b5 
+	MULXQ 40(SI), AX, BX
+	ADOXQ AX, R9
+	ADCXQ BX, R10
+
+	MOVQ  $0x00, AX
+	ADOXQ AX, R10
+
+	// | u4 = w0 * inp
+	MOVQ  R11, DX
+	MULXQ ·frInp+0(SB), DX, BX
+	XORQ  AX, AX
+
+	// | u4 * q0 
+	MULXQ ·frModulus+0(SB), AX, BX
+	ADOXQ AX, R11
+	ADCXQ BX, R12
+
+	// | u4 * q1 
+	MULXQ ·frModulus+8(SB), AX, BX
+	ADOXQ AX, R12
+	ADCXQ BX, R13
+
+	// | u4 * q2 
+	MULXQ ·frModulus+16(SB), AX, BX
+	ADOXQ AX, R13
+	ADCXQ BX, CX
+
+	// | u4 * q3 
+	MULXQ ·frModulus+24(SB), AX, BX
+	ADOXQ AX, CX
+	ADCXQ BX, R8
+
+	// | u4 * q4 
+	MULXQ ·frModulus+32(SB), AX, BX
+	ADOXQ AX, R8
+	ADCXQ BX, R9
+
+	// | u4 * q5 
+	MULXQ ·frModulus+40(SB), AX, BX
+	ADOXQ AX, R9
+	ADCXQ BX, R10
+
+	MOVQ  $0x00, AX
+	ADOXQ AX, R10
+
+	// | 
+
+/* i = 5                                   */
+
+	// | a5 @ DX
+	MOVQ 40(DI), DX
+	XORQ AX, AX
+
+	// | a5 * b0 
+	MULXQ (SI), AX, BX
+	ADOXQ AX, R12
+	ADCXQ BX, R13
+
+	// | a5 * b1 
+	MULXQ 8(SI), AX, BX
+	ADOXQ AX, R13
+	ADCXQ BX, CX
+
+	// | a5 * b2 
+	MULXQ 16(SI), AX, BX
+	ADOXQ AX, CX
+	ADCXQ BX, R8
+
+	// | a5 * b3 
+	MULXQ 24(SI), AX, BX
+	ADOXQ AX, R8
+	ADCXQ BX, R9
+
+	// | a5 * b4 
+	MULXQ 32(SI), AX, BX
+	ADOXQ AX, R9
+	ADCXQ BX, R10
+
+	// | a5 * b5 
+	MULXQ 40(SI), AX, BX
+	ADOXQ AX, R10
+	ADCXQ BX, R11
+
+	MOVQ  $0x00, AX
+	ADOXQ AX, R11
+
+	// | u5 = w0 * inp
+	MOVQ  R12, DX
+	MULXQ ·frInp+0(SB), DX, BX
+	XORQ  AX, AX
+
+	// | u5 * q0 
+	MULXQ ·frModulus+0(SB), AX, BX
+	ADOXQ AX, R12
+	ADCXQ BX, R13
+
+	// | u5 * q1 
+	MULXQ ·frModulus+8(SB), AX, BX
+	ADOXQ AX, R13
+	ADCXQ BX, CX
+
+	// | u5 * q2 
+	MULXQ ·frModulus+16(SB), AX, BX
+	ADOXQ AX, CX
+	ADCXQ BX, R8
+
+	// | u5 * q3 
+	MULXQ ·frModulus+24(SB), AX, BX
+	ADOXQ AX, R8
+	ADCXQ BX, R9
+
+	// | u5 * q4 
+	MULXQ ·frModulus+32(SB), AX, BX
+	ADOXQ AX, R9
+	ADCXQ BX, R10
+
+	// | u5 * q5 
+	MULXQ ·frModulus+40(SB), AX, BX
+	ADOXQ AX, R10
+	ADCXQ BX, R11
+
+	MOVQ  $0x00, AX
+	ADOXQ AX, R11
+
+	// | 
+
+/* modular reduction                       */
+
+	MOVQ R13, AX
+	SUBQ ·frModulus+0(SB), AX
+	MOVQ CX, BX
+	SBBQ ·frModulus+8(SB), BX
+	MOVQ R8, DX
+	SBBQ ·frModulus+16(SB), DX
+	MOVQ R9, SI
+	SBBQ ·frModulus+24(SB), SI
+	MOVQ R10, DI
+	SBBQ ·frModulus+32(SB), DI
+	MOVQ R11, R14
+	SBBQ ·frModulus+40(SB), R14
+
+	// |
+	CMOVQCC AX, R13
+	CMOVQCC BX, CX
+	CMOVQCC DX, R8
+	CMOVQCC SI, R9
+	CMOVQCC DI, R10
+	CMOVQCC R14, R11
+
+	// | 
+
+/* out                                     */
+
+	MOVQ c+0(FP), DI
+	MOVQ R13, (DI)
+	MOVQ CX, 8(DI)
+	MOVQ R8, 16(DI)
+	MOVQ R9, 24(DI)
+	MOVQ R10, 32(DI)
+	MOVQ R11, 40(DI)
+	RET
+/*	 | end													*/
+
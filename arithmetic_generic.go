@@ -0,0 +1,1182 @@
+package bw6
+
+// Native go field arithmetic code is generated with goff https://github.com/ConsenSys/goff
+// * Most of the function signatures are edited or renamed.
+// * Assigned and lazy operations are added.
+
+import (
+	"math/bits"
+)
+
+// element_ops_noasm.go
+// Copyright 2020 ConsenSys AG
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by goff (v0.3.2) DO NOT EDIT
+
+func addGeneric(z, x, y *fe) {
+	var carry uint64
+
+	z[0], carry = bits.Add64(x[0], y[0], 0)
+	z[1], carry = bits.Add64(x[1], y[1], carry)
+	z[2], carry = bits.Add64(x[2], y[2], carry)
+	z[3], carry = bits.Add64(x[3], y[3], carry)
+	z[4], carry = bits.Add64(x[4], y[4], carry)
+	z[5], carry = bits.Add64(x[5], y[5], carry)
+	z[6], carry = bits.Add64(x[6], y[6], carry)
+	z[7], carry = bits.Add64(x[7], y[7], carry)
+	z[8], carry = bits.Add64(x[8], y[8], carry)
+	z[9], carry = bits.Add64(x[9], y[9], carry)
+	z[10], carry = bits.Add64(x[10], y[10], carry)
+	z[11], _ = bits.Add64(x[11], y[11], carry)
+
+	// if z > q --> z -= q
+	// note: this is NOT constant time
+	if !(z[11] < 81882988782276106 || (z[11] == 81882988782276106 && (z[10] < 15098257552581525310 || (z[10] == 15098257552581525310 && (z[9] < 13341377791855249032 || (z[9] == 13341377791855249032 && (z[8] < 5945444129596489281 || (z[8] == 5945444129596489281 && (z[7] < 8105254717682411801 || (z[7] == 8105254717682411801 && (z[6] < 274362232328168196 || (z[6] == 274362232328168196 && (z[5] < 9694500593442880912 || (z[5] == 9694500593442880912 && (z[4] < 8204665564953313070 || (z[4] == 8204665564953313070 && (z[3] < 10998096788944562424 || (z[3] == 10998096788944562424 && (z[2] < 1588918198704579639 || (z[2] == 1588918198704579639 && (z[1] < 16614129118623039618 || (z[1] == 16614129118623039618 && (z[0] < 17626244516597989515))))))))))))))))))))))) {
+		var b uint64
+		z[0], b = bits.Sub64(z[0], 17626244516597989515, 0)
+		z[1], b = bits.Sub64(z[1], 16614129118623039618, b)
+		z[2], b = bits.Sub64(z[2], 1588918198704579639, b)
+		z[3], b = bits.Sub64(z[3], 10998096788944562424, b)
+		z[4], b = bits.Sub64(z[4], 8204665564953313070, b)
+		z[5], b = bits.Sub64(z[5], 9694500593442880912, b)
+		z[6], b = bits.Sub64(z[6], 274362232328168196, b)
+		z[7], b = bits.Sub64(z[7], 8105254717682411801, b)
+		z[8], b = bits.Sub64(z[8], 5945444129596489281, b)
+		z[9], b = bits.Sub64(z[9], 13341377791855249032, b)
+		z[10], b = bits.Sub64(z[10], 15098257552581525310, b)
+		z[11], _ = bits.Sub64(z[11], 81882988782276106, b)
+	}
+}
+
+func addAssignGeneric(z, y *fe) {
+	var carry uint64
+
+	z[0], carry = bits.Add64(z[0], y[0], 0)
+	z[1], carry = bits.Add64(z[1], y[1], carry)
+	z[2], carry = bits.Add64(z[2], y[2], carry)
+	z[3], carry = bits.Add64(z[3], y[3], carry)
+	z[4], carry = bits.Add64(z[4], y[4], carry)
+	z[5], carry = bits.Add64(z[5], y[5], carry)
+	z[6], carry = bits.Add64(z[6], y[6], carry)
+	z[7], carry = bits.Add64(z[7], y[7], carry)
+	z[8], carry = bits.Add64(z[8], y[8], carry)
+	z[9], carry = bits.Add64(z[9], y[9], carry)
+	z[10], carry = bits.Add64(z[10], y[10], carry)
+	z[11], _ = bits.Add64(z[11], y[11], carry)
+
+	// if z > q --> z -= q
+	// note: this is NOT constant time
+	if !(z[11] < 81882988782276106 || (z[11] == 81882988782276106 && (z[10] < 15098257552581525310 || (z[10] == 15098257552581525310 && (z[9] < 13341377791855249032 || (z[9] == 13341377791855249032 && (z[8] < 5945444129596489281 || (z[8] == 5945444129596489281 && (z[7] < 8105254717682411801 || (z[7] == 8105254717682411801 && (z[6] < 274362232328168196 || (z[6] == 274362232328168196 && (z[5] < 9694500593442880912 || (z[5] == 9694500593442880912 && (z[4] < 8204665564953313070 || (z[4] == 8204665564953313070 && (z[3] < 10998096788944562424 || (z[3] == 10998096788944562424 && (z[2] < 1588918198704579639 || (z[2] == 1588918198704579639 && (z[1] < 16614129118623039618 || (z[1] == 16614129118623039618 && (z[0] < 17626244516597989515))))))))))))))))))))))) {
+		var b uint64
+		z[0], b = bits.Sub64(z[0], 17626244516597989515, 0)
+		z[1], b = bits.Sub64(z[1], 16614129118623039618, b)
+		z[2], b = bits.Sub64(z[2], 1588918198704579639, b)
+		z[3], b = bits.Sub64(z[3], 10998096788944562424, b)
+		z[4], b = bits.Sub64(z[4], 8204665564953313070, b)
+		z[5], b = bits.Sub64(z[5], 9694500593442880912, b)
+		z[6], b = bits.Sub64(z[6], 274362232328168196, b)
+		z[7], b = bits.Sub64(z[7], 8105254717682411801, b)
+		z[8], b = bits.Sub64(z[8], 5945444129596489281, b)
+		z[9], b = bits.Sub64(z[9], 13341377791855249032, b)
+		z[10], b = bits.Sub64(z[10], 15098257552581525310, b)
+		z[11], _ = bits.Sub64(z[11], 81882988782276106, b)
+	}
+}
+
+func laddGeneric(z, x, y *fe) {
+	var carry uint64
+
+	z[0], carry = bits.Add64(x[0], y[0], 0)
+	z[1], carry = bits.Add64(x[1], y[1], carry)
+	z[2], carry = bits.Add64(x[2], y[2], carry)
+	z[3], carry = bits.Add64(x[3], y[3], carry)
+	z[4], carry = bits.Add64(x[4], y[4], carry)
+	z[5], carry = bits.Add64(x[5], y[5], carry)
+	z[6], carry = bits.Add64(x[6], y[6], carry)
+	z[7], carry = bits.Add64(x[7], y[7], carry)
+	z[8], carry = bits.Add64(x[8], y[8], carry)
+	z[9], carry = bits.Add64(x[9], y[9], carry)
+	z[10], carry = bits.Add64(x[10], y[10], carry)
+	z[11], _ = bits.Add64(x[11], y[11], carry)
+}
+
+func laddAssignGeneric(z, y *fe) {
+	var carry uint64
+
+	z[0], carry = bits.Add64(z[0], y[0], 0)
+	z[1], carry = bits.Add64(z[1], y[1], carry)
+	z[2], carry = bits.Add64(z[2], y[2], carry)
+	z[3], carry = bits.Add64(z[3], y[3], carry)
+	z[4], carry = bits.Add64(z[4], y[4], carry)
+	z[5], carry = bits.Add64(z[5], y[5], carry)
+	z[6], carry = bits.Add64(z[6], y[6], carry)
+	z[7], carry = bits.Add64(z[7], y[7], carry)
+	z[8], carry = bits.Add64(z[8], y[8], carry)
+	z[9], carry = bits.Add64(z[9], y[9], carry)
+	z[10], carry = bits.Add64(z[10], y[10], carry)
+	z[11], _ = bits.Add64(z[11], y[11], carry)
+}
+
+func doubleGeneric(z, x *fe) {
+	var carry uint64
+
+	z[0], carry = bits.Add64(x[0], x[0], 0)
+	z[1], carry = bits.Add64(x[1], x[1], carry)
+	z[2], carry = bits.Add64(x[2], x[2], carry)
+	z[3], carry = bits.Add64(x[3], x[3], carry)
+	z[4], carry = bits.Add64(x[4], x[4], carry)
+	z[5], carry = bits.Add64(x[5], x[5], carry)
+	z[6], carry = bits.Add64(x[6], x[6], carry)
+	z[7], carry = bits.Add64(x[7], x[7], carry)
+	z[8], carry = bits.Add64(x[8], x[8], carry)
+	z[9], carry = bits.Add64(x[9], x[9], carry)
+	z[10], carry = bits.Add64(x[10], x[10], carry)
+	z[11], _ = bits.Add64(x[11], x[11], carry)
+
+	// if z > q --> z -= q
+	// note: this is NOT constant time
+	if !(z[11] < 81882988782276106 || (z[11] == 81882988782276106 && (z[10] < 15098257552581525310 || (z[10] == 15098257552581525310 && (z[9] < 13341377791855249032 || (z[9] == 13341377791855249032 && (z[8] < 5945444129596489281 || (z[8] == 5945444129596489281 && (z[7] < 8105254717682411801 || (z[7] == 8105254717682411801 && (z[6] < 274362232328168196 || (z[6] == 274362232328168196 && (z[5] < 9694500593442880912 || (z[5] == 9694500593442880912 && (z[4] < 8204665564953313070 || (z[4] == 8204665564953313070 && (z[3] < 10998096788944562424 || (z[3] == 10998096788944562424 && (z[2] < 1588918198704579639 || (z[2] == 1588918198704579639 && (z[1] < 16614129118623039618 || (z[1] == 16614129118623039618 && (z[0] < 17626244516597989515))))))))))))))))))))))) {
+		var b uint64
+		z[0], b = bits.Sub64(z[0], 17626244516597989515, 0)
+		z[1], b = bits.Sub64(z[1], 16614129118623039618, b)
+		z[2], b = bits.Sub64(z[2], 1588918198704579639, b)
+		z[3], b = bits.Sub64(z[3], 10998096788944562424, b)
+		z[4], b = bits.Sub64(z[4], 8204665564953313070, b)
+		z[5], b = bits.Sub64(z[5], 9694500593442880912, b)
+		z[6], b = bits.Sub64(z[6], 274362232328168196, b)
+		z[7], b = bits.Sub64(z[7], 8105254717682411801, b)
+		z[8], b = bits.Sub64(z[8], 5945444129596489281, b)
+		z[9], b = bits.Sub64(z[9], 13341377791855249032, b)
+		z[10], b = bits.Sub64(z[10], 15098257552581525310, b)
+		z[11], _ = bits.Sub64(z[11], 81882988782276106, b)
+	}
+}
+
+func doubleAssignGeneric(z *fe) {
+	var carry uint64
+
+	z[0], carry = bits.Add64(z[0], z[0], 0)
+	z[1], carry = bits.Add64(z[1], z[1], carry)
+	z[2], carry = bits.Add64(z[2], z[2], carry)
+	z[3], carry = bits.Add64(z[3], z[3], carry)
+	z[4], carry = bits.Add64(z[4], z[4], carry)
+	z[5], carry = bits.Add64(z[5], z[5], carry)
+	z[6], carry = bits.Add64(z[6], z[6], carry)
+	z[7], carry = bits.Add64(z[7], z[7], carry)
+	z[8], carry = bits.Add64(z[8], z[8], carry)
+	z[9], carry = bits.Add64(z[9], z[9], carry)
+	z[10], carry = bits.Add64(z[10], z[10], carry)
+	z[11], _ = bits.Add64(z[11], z[11], carry)
+
+	// if z > q --> z -= q
+	// note: this is NOT constant time
+	if !(z[11] < 81882988782276106 || (z[11] == 81882988782276106 && (z[10] < 15098257552581525310 || (z[10] == 15098257552581525310 && (z[9] < 13341377791855249032 || (z[9] == 13341377791855249032 && (z[8] < 5945444129596489281 || (z[8] == 5945444129596489281 && (z[7] < 8105254717682411801 || (z[7] == 8105254717682411801 && (z[6] < 274362232328168196 || (z[6] == 274362232328168196 && (z[5] < 9694500593442880912 || (z[5] == 9694500593442880912 && (z[4] < 8204665564953313070 || (z[4] == 8204665564953313070 && (z[3] < 10998096788944562424 || (z[3] == 10998096788944562424 && (z[2] < 1588918198704579639 || (z[2] == 1588918198704579639 && (z[1] < 16614129118623039618 || (z[1] == 16614129118623039618 && (z[0] < 17626244516597989515))))))))))))))))))))))) {
+		var b uint64
+		z[0], b = bits.Sub64(z[0], 17626244516597989515, 0)
+		z[1], b = bits.Sub64(z[1], 16614129118623039618, b)
+		z[2], b = bits.Sub64(z[2], 1588918198704579639, b)
+		z[3], b = bits.Sub64(z[3], 10998096788944562424, b)
+		z[4], b = bits.Sub64(z[4], 8204665564953313070, b)
+		z[5], b = bits.Sub64(z[5], 9694500593442880912, b)
+		z[6], b = bits.Sub64(z[6], 274362232328168196, b)
+		z[7], b = bits.Sub64(z[7], 8105254717682411801, b)
+		z[8], b = bits.Sub64(z[8], 5945444129596489281, b)
+		z[9], b = bits.Sub64(z[9], 13341377791855249032, b)
+		z[10], b = bits.Sub64(z[10], 15098257552581525310, b)
+		z[11], _ = bits.Sub64(z[11], 81882988782276106, b)
+	}
+}
+
+func ldoubleGeneric(z, x *fe) {
+	var carry uint64
+
+	z[0], carry = bits.Add64(x[0], x[0], 0)
+	z[1], carry = bits.Add64(x[1], x[1], carry)
+	z[2], carry = bits.Add64(x[2], x[2], carry)
+	z[3], carry = bits.Add64(x[3], x[3], carry)
+	z[4], carry = bits.Add64(x[4], x[4], carry)
+	z[5], carry = bits.Add64(x[5], x[5], carry)
+	z[6], carry = bits.Add64(x[6], x[6], carry)
+	z[7], carry = bits.Add64(x[7], x[7], carry)
+	z[8], carry = bits.Add64(x[8], x[8], carry)
+	z[9], carry = bits.Add64(x[9], x[9], carry)
+	z[10], carry = bits.Add64(x[10], x[10], carry)
+	z[11], _ = bits.Add64(x[11], x[11], carry)
+}
+
+func ldoubleAssignGeneric(z *fe) {
+	var carry uint64
+
+	z[0], carry = bits.Add64(z[0], z[0], 0)
+	z[1], carry = bits.Add64(z[1], z[1], carry)
+	z[2], carry = bits.Add64(z[2], z[2], carry)
+	z[3], carry = bits.Add64(z[3], z[3], carry)
+	z[4], carry = bits.Add64(z[4], z[4], carry)
+	z[5], carry = bits.Add64(z[5], z[5], carry)
+	z[6], carry = bits.Add64(z[6], z[6], carry)
+	z[7], carry = bits.Add64(z[7], z[7], carry)
+	z[8], carry = bits.Add64(z[8], z[8], carry)
+	z[9], carry = bits.Add64(z[9], z[9], carry)
+	z[10], carry = bits.Add64(z[10], z[10], carry)
+	z[11], _ = bits.Add64(z[11], z[11], carry)
+}
+
+func subGeneric(z, x, y *fe) {
+	var b uint64
+	z[0], b = bits.Sub64(x[0], y[0], 0)
+	z[1], b = bits.Sub64(x[1], y[1], b)
+	z[2], b = bits.Sub64(x[2], y[2], b)
+	z[3], b = bits.Sub64(x[3], y[3], b)
+	z[4], b = bits.Sub64(x[4], y[4], b)
+	z[5], b = bits.Sub64(x[5], y[5], b)
+	z[6], b = bits.Sub64(x[6], y[6], b)
+	z[7], b = bits.Sub64(x[7], y[7], b)
+	z[8], b = bits.Sub64(x[8], y[8], b)
+	z[9], b = bits.Sub64(x[9], y[9], b)
+	z[10], b = bits.Sub64(x[10], y[10], b)
+	z[11], b = bits.Sub64(x[11], y[11], b)
+	if b != 0 {
+		var c uint64
+		z[0], c = bits.Add64(z[0], 17626244516597989515, 0)
+		z[1], c = bits.Add64(z[1], 16614129118623039618, c)
+		z[2], c = bits.Add64(z[2], 1588918198704579639, c)
+		z[3], c = bits.Add64(z[3], 10998096788944562424, c)
+		z[4], c = bits.Add64(z[4], 8204665564953313070, c)
+		z[5], c = bits.Add64(z[5], 9694500593442880912, c)
+		z[6], c = bits.Add64(z[6], 274362232328168196, c)
+		z[7], c = bits.Add64(z[7], 8105254717682411801, c)
+		z[8], c = bits.Add64(z[8], 5945444129596489281, c)
+		z[9], c = bits.Add64(z[9], 13341377791855249032, c)
+		z[10], c = bits.Add64(z[10], 15098257552581525310, c)
+		z[11], _ = bits.Add64(z[11], 81882988782276106, c)
+	}
+}
+
+func subAssignGeneric(z, y *fe) {
+	var b uint64
+	z[0], b = bits.Sub64(z[0], y[0], 0)
+	z[1], b = bits.Sub64(z[1], y[1], b)
+	z[2], b = bits.Sub64(z[2], y[2], b)
+	z[3], b = bits.Sub64(z[3], y[3], b)
+	z[4], b = bits.Sub64(z[4], y[4], b)
+	z[5], b = bits.Sub64(z[5], y[5], b)
+	z[6], b = bits.Sub64(z[6], y[6], b)
+	z[7], b = bits.Sub64(z[7], y[7], b)
+	z[8], b = bits.Sub64(z[8], y[8], b)
+	z[9], b = bits.Sub64(z[9], y[9], b)
+	z[10], b = bits.Sub64(z[10], y[10], b)
+	z[11], b = bits.Sub64(z[11], y[11], b)
+	if b != 0 {
+		var c uint64
+		z[0], c = bits.Add64(z[0], 17626244516597989515, 0)
+		z[1], c = bits.Add64(z[1], 16614129118623039618, c)
+		z[2], c = bits.Add64(z[2], 1588918198704579639, c)
+		z[3], c = bits.Add64(z[3], 10998096788944562424, c)
+		z[4], c = bits.Add64(z[4], 8204665564953313070, c)
+		z[5], c = bits.Add64(z[5], 9694500593442880912, c)
+		z[6], c = bits.Add64(z[6], 274362232328168196, c)
+		z[7], c = bits.Add64(z[7], 8105254717682411801, c)
+		z[8], c = bits.Add64(z[8], 5945444129596489281, c)
+		z[9], c = bits.Add64(z[9], 13341377791855249032, c)
+		z[10], c = bits.Add64(z[10], 15098257552581525310, c)
+		z[11], _ = bits.Add64(z[11], 81882988782276106, c)
+	}
+}
+
+func lsubGeneric(z, x, y *fe) {
+	var b uint64
+	z[0], b = bits.Sub64(x[0], y[0], 0)
+	z[1], b = bits.Sub64(x[1], y[1], b)
+	z[2], b = bits.Sub64(x[2], y[2], b)
+	z[3], b = bits.Sub64(x[3], y[3], b)
+	z[4], b = bits.Sub64(x[4], y[4], b)
+	z[5], b = bits.Sub64(x[5], y[5], b)
+	z[6], b = bits.Sub64(x[6], y[6], b)
+	z[7], b = bits.Sub64(x[7], y[7], b)
+	z[8], b = bits.Sub64(x[8], y[8], b)
+	z[9], b = bits.Sub64(x[9], y[9], b)
+	z[10], b = bits.Sub64(x[10], y[10], b)
+	z[11], b = bits.Sub64(x[11], y[11], b)
+	if b != 0 {
+		var c uint64
+		z[0], c = bits.Add64(z[0], 17626244516597989515, 0)
+		z[1], c = bits.Add64(z[1], 16614129118623039618, c)
+		z[2], c = bits.Add64(z[2], 1588918198704579639, c)
+		z[3], c = bits.Add64(z[3], 10998096788944562424, c)
+		z[4], c = bits.Add64(z[4], 8204665564953313070, c)
+		z[5], c = bits.Add64(z[5], 9694500593442880912, c)
+		z[6], c = bits.Add64(z[6], 274362232328168196, c)
+		z[7], c = bits.Add64(z[7], 8105254717682411801, c)
+		z[8], c = bits.Add64(z[8], 5945444129596489281, c)
+		z[9], c = bits.Add64(z[9], 13341377791855249032, c)
+		z[10], c = bits.Add64(z[10], 15098257552581525310, c)
+		z[11], _ = bits.Add64(z[11], 81882988782276106, c)
+	}
+}
+
+func lsubAssignGeneric(z, y *fe) {
+	var b uint64
+	z[0], b = bits.Sub64(z[0], y[0], 0)
+	z[1], b = bits.Sub64(z[1], y[1], b)
+	z[2], b = bits.Sub64(z[2], y[2], b)
+	z[3], b = bits.Sub64(z[3], y[3], b)
+	z[4], b = bits.Sub64(z[4], y[4], b)
+	z[5], b = bits.Sub64(z[5], y[5], b)
+	z[6], b = bits.Sub64(z[6], y[6], b)
+	z[7], b = bits.Sub64(z[7], y[7], b)
+	z[8], b = bits.Sub64(z[8], y[8], b)
+	z[9], b = bits.Sub64(z[9], y[9], b)
+	z[10], b = bits.Sub64(z[10], y[10], b)
+	z[11], b = bits.Sub64(z[11], y[11], b)
+	if b != 0 {
+		var c uint64
+		z[0], c = bits.Add64(z[0], 17626244516597989515, 0)
+		z[1], c = bits.Add64(z[1], 16614129118623039618, c)
+		z[2], c = bits.Add64(z[2], 1588918198704579639, c)
+		z[3], c = bits.Add64(z[3], 10998096788944562424, c)
+		z[4], c = bits.Add64(z[4], 8204665564953313070, c)
+		z[5], c = bits.Add64(z[5], 9694500593442880912, c)
+		z[6], c = bits.Add64(z[6], 274362232328168196, c)
+		z[7], c = bits.Add64(z[7], 8105254717682411801, c)
+		z[8], c = bits.Add64(z[8], 5945444129596489281, c)
+		z[9], c = bits.Add64(z[9], 13341377791855249032, c)
+		z[10], c = bits.Add64(z[10], 15098257552581525310, c)
+		z[11], _ = bits.Add64(z[11], 81882988782276106, c)
+	}
+}
+
+func negGeneric(z, x *fe) {
+	if x.isZero() {
+		z.zero()
+		return
+	}
+	var borrow uint64
+	z[0], borrow = bits.Sub64(17626244516597989515, x[0], 0)
+	z[1], borrow = bits.Sub64(16614129118623039618, x[1], borrow)
+	z[2], borrow = bits.Sub64(1588918198704579639, x[2], borrow)
+	z[3], borrow = bits.Sub64(10998096788944562424, x[3], borrow)
+	z[4], borrow = bits.Sub64(8204665564953313070, x[4], borrow)
+	z[5], borrow = bits.Sub64(9694500593442880912, x[5], borrow)
+	z[6], borrow = bits.Sub64(274362232328168196, x[6], borrow)
+	z[7], borrow = bits.Sub64(8105254717682411801, x[7], borrow)
+	z[8], borrow = bits.Sub64(5945444129596489281, x[8], borrow)
+	z[9], borrow = bits.Sub64(13341377791855249032, x[9], borrow)
+	z[10], borrow = bits.Sub64(15098257552581525310, x[10], borrow)
+	z[11], _ = bits.Sub64(81882988782276106, x[11], borrow)
+}
+
+// element.go
+// Copyright 2020 ConsenSys AG
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by goff (v0.3.2) DO NOT EDIT
+
+func mulGeneric(z, x, y *fe) {
+
+	var t [12]uint64
+	var c [3]uint64
+	{
+		// round 0
+		v := x[0]
+		c[1], c[0] = bits.Mul64(v, y[0])
+		m := c[0] * 744663313386281181
+		c[2] = madd0(m, 17626244516597989515, c[0])
+		c[1], c[0] = madd1(v, y[1], c[1])
+		c[2], t[0] = madd2(m, 16614129118623039618, c[2], c[0])
+		c[1], c[0] = madd1(v, y[2], c[1])
+		c[2], t[1] = madd2(m, 1588918198704579639, c[2], c[0])
+		c[1], c[0] = madd1(v, y[3], c[1])
+		c[2], t[2] = madd2(m, 10998096788944562424, c[2], c[0])
+		c[1], c[0] = madd1(v, y[4], c[1])
+		c[2], t[3] = madd2(m, 8204665564953313070, c[2], c[0])
+		c[1], c[0] = madd1(v, y[5], c[1])
+		c[2], t[4] = madd2(m, 9694500593442880912, c[2], c[0])
+		c[1], c[0] = madd1(v, y[6], c[1])
+		c[2], t[5] = madd2(m, 274362232328168196, c[2], c[0])
+		c[1], c[0] = madd1(v, y[7], c[1])
+		c[2], t[6] = madd2(m, 8105254717682411801, c[2], c[0])
+		c[1], c[0] = madd1(v, y[8], c[1])
+		c[2], t[7] = madd2(m, 5945444129596489281, c[2], c[0])
+		c[1], c[0] = madd1(v, y[9], c[1])
+		c[2], t[8] = madd2(m, 13341377791855249032, c[2], c[0])
+		c[1], c[0] = madd1(v, y[10], c[1])
+		c[2], t[9] = madd2(m, 15098257552581525310, c[2], c[0])
+		c[1], c[0] = madd1(v, y[11], c[1])
+		t[11], t[10] = madd3(m, 81882988782276106, c[0], c[2], c[1])
+	}
+	{
+		// round 1
+		v := x[1]
+		c[1], c[0] = madd1(v, y[0], t[0])
+		m := c[0] * 744663313386281181
+		c[2] = madd0(m, 17626244516597989515, c[0])
+		c[1], c[0] = madd2(v, y[1], c[1], t[1])
+		c[2], t[0] = madd2(m, 16614129118623039618, c[2], c[0])
+		c[1], c[0] = madd2(v, y[2], c[1], t[2])
+		c[2], t[1] = madd2(m, 1588918198704579639, c[2], c[0])
+		c[1], c[0] = madd2(v, y[3], c[1], t[3])
+		c[2], t[2] = madd2(m, 10998096788944562424, c[2], c[0])
+		c[1], c[0] = madd2(v, y[4], c[1], t[4])
+		c[2], t[3] = madd2(m, 8204665564953313070, c[2], c[0])
+		c[1], c[0] = madd2(v, y[5], c[1], t[5])
+		c[2], t[4] = madd2(m, 9694500593442880912, c[2], c[0])
+		c[1], c[0] = madd2(v, y[6], c[1], t[6])
+		c[2], t[5] = madd2(m, 274362232328168196, c[2], c[0])
+		c[1], c[0] = madd2(v, y[7], c[1], t[7])
+		c[2], t[6] = madd2(m, 8105254717682411801, c[2], c[0])
+		c[1], c[0] = madd2(v, y[8], c[1], t[8])
+		c[2], t[7] = madd2(m, 5945444129596489281, c[2], c[0])
+		c[1], c[0] = madd2(v, y[9], c[1], t[9])
+		c[2], t[8] = madd2(m, 13341377791855249032, c[2], c[0])
+		c[1], c[0] = madd2(v, y[10], c[1], t[10])
+		c[2], t[9] = madd2(m, 15098257552581525310, c[2], c[0])
+		c[1], c[0] = madd2(v, y[11], c[1], t[11])
+		t[11], t[10] = madd3(m, 81882988782276106, c[0], c[2], c[1])
+	}
+	{
+		// round 2
+		v := x[2]
+		c[1], c[0] = madd1(v, y[0], t[0])
+		m := c[0] * 744663313386281181
+		c[2] = madd0(m, 17626244516597989515, c[0])
+		c[1], c[0] = madd2(v, y[1], c[1], t[1])
+		c[2], t[0] = madd2(m, 16614129118623039618, c[2], c[0])
+		c[1], c[0] = madd2(v, y[2], c[1], t[2])
+		c[2], t[1] = madd2(m, 1588918198704579639, c[2], c[0])
+		c[1], c[0] = madd2(v, y[3], c[1], t[3])
+		c[2], t[2] = madd2(m, 10998096788944562424, c[2], c[0])
+		c[1], c[0] = madd2(v, y[4], c[1], t[4])
+		c[2], t[3] = madd2(m, 8204665564953313070, c[2], c[0])
+		c[1], c[0] = madd2(v, y[5], c[1], t[5])
+		c[2], t[4] = madd2(m, 9694500593442880912, c[2], c[0])
+		c[1], c[0] = madd2(v, y[6], c[1], t[6])
+		c[2], t[5] = madd2(m, 274362232328168196, c[2], c[0])
+		c[1], c[0] = madd2(v, y[7], c[1], t[7])
+		c[2], t[6] = madd2(m, 8105254717682411801, c[2], c[0])
+		c[1], c[0] = madd2(v, y[8], c[1], t[8])
+		c[2], t[7] = madd2(m, 5945444129596489281, c[2], c[0])
+		c[1], c[0] = madd2(v, y[9], c[1], t[9])
+		c[2], t[8] = madd2(m, 13341377791855249032, c[2], c[0])
+		c[1], c[0] = madd2(v, y[10], c[1], t[10])
+		c[2], t[9] = madd2(m, 15098257552581525310, c[2], c[0])
+		c[1], c[0] = madd2(v, y[11], c[1], t[11])
+		t[11], t[10] = madd3(m, 81882988782276106, c[0], c[2], c[1])
+	}
+	{
+		// round 3
+		v := x[3]
+		c[1], c[0] = madd1(v, y[0], t[0])
+		m := c[0] * 744663313386281181
+		c[2] = madd0(m, 17626244516597989515, c[0])
+		c[1], c[0] = madd2(v, y[1], c[1], t[1])
+		c[2], t[0] = madd2(m, 16614129118623039618, c[2], c[0])
+		c[1], c[0] = madd2(v, y[2], c[1], t[2])
+		c[2], t[1] = madd2(m, 1588918198704579639, c[2], c[0])
+		c[1], c[0] = madd2(v, y[3], c[1], t[3])
+		c[2], t[2] = madd2(m, 10998096788944562424, c[2], c[0])
+		c[1], c[0] = madd2(v, y[4], c[1], t[4])
+		c[2], t[3] = madd2(m, 8204665564953313070, c[2], c[0])
+		c[1], c[0] = madd2(v, y[5], c[1], t[5])
+		c[2], t[4] = madd2(m, 9694500593442880912, c[2], c[0])
+		c[1], c[0] = madd2(v, y[6], c[1], t[6])
+		c[2], t[5] = madd2(m, 274362232328168196, c[2], c[0])
+		c[1], c[0] = madd2(v, y[7], c[1], t[7])
+		c[2], t[6] = madd2(m, 8105254717682411801, c[2], c[0])
+		c[1], c[0] = madd2(v, y[8], c[1], t[8])
+		c[2], t[7] = madd2(m, 5945444129596489281, c[2], c[0])
+		c[1], c[0] = madd2(v, y[9], c[1], t[9])
+		c[2], t[8] = madd2(m, 13341377791855249032, c[2], c[0])
+		c[1], c[0] = madd2(v, y[10], c[1], t[10])
+		c[2], t[9] = madd2(m, 15098257552581525310, c[2], c[0])
+		c[1], c[0] = madd2(v, y[11], c[1], t[11])
+		t[11], t[10] = madd3(m, 81882988782276106, c[0], c[2], c[1])
+	}
+	{
+		// round 4
+		v := x[4]
+		c[1], c[0] = madd1(v, y[0], t[0])
+		m := c[0] * 744663313386281181
+		c[2] = madd0(m, 17626244516597989515, c[0])
+		c[1], c[0] = madd2(v, y[1], c[1], t[1])
+		c[2], t[0] = madd2(m, 16614129118623039618, c[2], c[0])
+		c[1], c[0] = madd2(v, y[2], c[1], t[2])
+		c[2], t[1] = madd2(m, 1588918198704579639, c[2], c[0])
+		c[1], c[0] = madd2(v, y[3], c[1], t[3])
+		c[2], t[2] = madd2(m, 10998096788944562424, c[2], c[0])
+		c[1], c[0] = madd2(v, y[4], c[1], t[4])
+		c[2], t[3] = madd2(m, 8204665564953313070, c[2], c[0])
+		c[1], c[0] = madd2(v, y[5], c[1], t[5])
+		c[2], t[4] = madd2(m, 9694500593442880912, c[2], c[0])
+		c[1], c[0] = madd2(v, y[6], c[1], t[6])
+		c[2], t[5] = madd2(m, 274362232328168196, c[2], c[0])
+		c[1], c[0] = madd2(v, y[7], c[1], t[7])
+		c[2], t[6] = madd2(m, 8105254717682411801, c[2], c[0])
+		c[1], c[0] = madd2(v, y[8], c[1], t[8])
+		c[2], t[7] = madd2(m, 5945444129596489281, c[2], c[0])
+		c[1], c[0] = madd2(v, y[9], c[1], t[9])
+		c[2], t[8] = madd2(m, 13341377791855249032, c[2], c[0])
+		c[1], c[0] = madd2(v, y[10], c[1], t[10])
+		c[2], t[9] = madd2(m, 15098257552581525310, c[2], c[0])
+		c[1], c[0] = madd2(v, y[11], c[1], t[11])
+		t[11], t[10] = madd3(m, 81882988782276106, c[0], c[2], c[1])
+	}
+	{
+		// round 5
+		v := x[5]
+		c[1], c[0] = madd1(v, y[0], t[0])
+		m := c[0] * 744663313386281181
+		c[2] = madd0(m, 17626244516597989515, c[0])
+		c[1], c[0] = madd2(v, y[1], c[1], t[1])
+		c[2], t[0] = madd2(m, 16614129118623039618, c[2], c[0])
+		c[1], c[0] = madd2(v, y[2], c[1], t[2])
+		c[2], t[1] = madd2(m, 1588918198704579639, c[2], c[0])
+		c[1], c[0] = madd2(v, y[3], c[1], t[3])
+		c[2], t[2] = madd2(m, 10998096788944562424, c[2], c[0])
+		c[1], c[0] = madd2(v, y[4], c[1], t[4])
+		c[2], t[3] = madd2(m, 8204665564953313070, c[2], c[0])
+		c[1], c[0] = madd2(v, y[5], c[1], t[5])
+		c[2], t[4] = madd2(m, 9694500593442880912, c[2], c[0])
+		c[1], c[0] = madd2(v, y[6], c[1], t[6])
+		c[2], t[5] = madd2(m, 274362232328168196, c[2], c[0])
+		c[1], c[0] = madd2(v, y[7], c[1], t[7])
+		c[2], t[6] = madd2(m, 8105254717682411801, c[2], c[0])
+		c[1], c[0] = madd2(v, y[8], c[1], t[8])
+		c[2], t[7] = madd2(m, 5945444129596489281, c[2], c[0])
+		c[1], c[0] = madd2(v, y[9], c[1], t[9])
+		c[2], t[8] = madd2(m, 13341377791855249032, c[2], c[0])
+		c[1], c[0] = madd2(v, y[10], c[1], t[10])
+		c[2], t[9] = madd2(m, 15098257552581525310, c[2], c[0])
+		c[1], c[0] = madd2(v, y[11], c[1], t[11])
+		t[11], t[10] = madd3(m, 81882988782276106, c[0], c[2], c[1])
+	}
+	{
+		// round 6
+		v := x[6]
+		c[1], c[0] = madd1(v, y[0], t[0])
+		m := c[0] * 744663313386281181
+		c[2] = madd0(m, 17626244516597989515, c[0])
+		c[1], c[0] = madd2(v, y[1], c[1], t[1])
+		c[2], t[0] = madd2(m, 16614129118623039618, c[2], c[0])
+		c[1], c[0] = madd2(v, y[2], c[1], t[2])
+		c[2], t[1] = madd2(m, 1588918198704579639, c[2], c[0])
+		c[1], c[0] = madd2(v, y[3], c[1], t[3])
+		c[2], t[2] = madd2(m, 10998096788944562424, c[2], c[0])
+		c[1], c[0] = madd2(v, y[4], c[1], t[4])
+		c[2], t[3] = madd2(m, 8204665564953313070, c[2], c[0])
+		c[1], c[0] = madd2(v, y[5], c[1], t[5])
+		c[2], t[4] = madd2(m, 9694500593442880912, c[2], c[0])
+		c[1], c[0] = madd2(v, y[6], c[1], t[6])
+		c[2], t[5] = madd2(m, 274362232328168196, c[2], c[0])
+		c[1], c[0] = madd2(v, y[7], c[1], t[7])
+		c[2], t[6] = madd2(m, 8105254717682411801, c[2], c[0])
+		c[1], c[0] = madd2(v, y[8], c[1], t[8])
+		c[2], t[7] = madd2(m, 5945444129596489281, c[2], c[0])
+		c[1], c[0] = madd2(v, y[9], c[1], t[9])
+		c[2], t[8] = madd2(m, 13341377791855249032, c[2], c[0])
+		c[1], c[0] = madd2(v, y[10], c[1], t[10])
+		c[2], t[9] = madd2(m, 15098257552581525310, c[2], c[0])
+		c[1], c[0] = madd2(v, y[11], c[1], t[11])
+		t[11], t[10] = madd3(m, 81882988782276106, c[0], c[2], c[1])
+	}
+	{
+		// round 7
+		v := x[7]
+		c[1], c[0] = madd1(v, y[0], t[0])
+		m := c[0] * 744663313386281181
+		c[2] = madd0(m, 17626244516597989515, c[0])
+		c[1], c[0] = madd2(v, y[1], c[1], t[1])
+		c[2], t[0] = madd2(m, 16614129118623039618, c[2], c[0])
+		c[1], c[0] = madd2(v, y[2], c[1], t[2])
+		c[2], t[1] = madd2(m, 1588918198704579639, c[2], c[0])
+		c[1], c[0] = madd2(v, y[3], c[1], t[3])
+		c[2], t[2] = madd2(m, 10998096788944562424, c[2], c[0])
+		c[1], c[0] = madd2(v, y[4], c[1], t[4])
+		c[2], t[3] = madd2(m, 8204665564953313070, c[2], c[0])
+		c[1], c[0] = madd2(v, y[5], c[1], t[5])
+		c[2], t[4] = madd2(m, 9694500593442880912, c[2], c[0])
+		c[1], c[0] = madd2(v, y[6], c[1], t[6])
+		c[2], t[5] = madd2(m, 274362232328168196, c[2], c[0])
+		c[1], c[0] = madd2(v, y[7], c[1], t[7])
+		c[2], t[6] = madd2(m, 8105254717682411801, c[2], c[0])
+		c[1], c[0] = madd2(v, y[8], c[1], t[8])
+		c[2], t[7] = madd2(m, 5945444129596489281, c[2], c[0])
+		c[1], c[0] = madd2(v, y[9], c[1], t[9])
+		c[2], t[8] = madd2(m, 13341377791855249032, c[2], c[0])
+		c[1], c[0] = madd2(v, y[10], c[1], t[10])
+		c[2], t[9] = madd2(m, 15098257552581525310, c[2], c[0])
+		c[1], c[0] = madd2(v, y[11], c[1], t[11])
+		t[11], t[10] = madd3(m, 81882988782276106, c[0], c[2], c[1])
+	}
+	{
+		// round 8
+		v := x[8]
+		c[1], c[0] = madd1(v, y[0], t[0])
+		m := c[0] * 744663313386281181
+		c[2] = madd0(m, 17626244516597989515, c[0])
+		c[1], c[0] = madd2(v, y[1], c[1], t[1])
+		c[2], t[0] = madd2(m, 16614129118623039618, c[2], c[0])
+		c[1], c[0] = madd2(v, y[2], c[1], t[2])
+		c[2], t[1] = madd2(m, 1588918198704579639, c[2], c[0])
+		c[1], c[0] = madd2(v, y[3], c[1], t[3])
+		c[2], t[2] = madd2(m, 10998096788944562424, c[2], c[0])
+		c[1], c[0] = madd2(v, y[4], c[1], t[4])
+		c[2], t[3] = madd2(m, 8204665564953313070, c[2], c[0])
+		c[1], c[0] = madd2(v, y[5], c[1], t[5])
+		c[2], t[4] = madd2(m, 9694500593442880912, c[2], c[0])
+		c[1], c[0] = madd2(v, y[6], c[1], t[6])
+		c[2], t[5] = madd2(m, 274362232328168196, c[2], c[0])
+		c[1], c[0] = madd2(v, y[7], c[1], t[7])
+		c[2], t[6] = madd2(m, 8105254717682411801, c[2], c[0])
+		c[1], c[0] = madd2(v, y[8], c[1], t[8])
+		c[2], t[7] = madd2(m, 5945444129596489281, c[2], c[0])
+		c[1], c[0] = madd2(v, y[9], c[1], t[9])
+		c[2], t[8] = madd2(m, 13341377791855249032, c[2], c[0])
+		c[1], c[0] = madd2(v, y[10], c[1], t[10])
+		c[2], t[9] = madd2(m, 15098257552581525310, c[2], c[0])
+		c[1], c[0] = madd2(v, y[11], c[1], t[11])
+		t[11], t[10] = madd3(m, 81882988782276106, c[0], c[2], c[1])
+	}
+	{
+		// round 9
+		v := x[9]
+		c[1], c[0] = madd1(v, y[0], t[0])
+		m := c[0] * 744663313386281181
+		c[2] = madd0(m, 17626244516597989515, c[0])
+		c[1], c[0] = madd2(v, y[1], c[1], t[1])
+		c[2], t[0] = madd2(m, 16614129118623039618, c[2], c[0])
+		c[1], c[0] = madd2(v, y[2], c[1], t[2])
+		c[2], t[1] = madd2(m, 1588918198704579639, c[2], c[0])
+		c[1], c[0] = madd2(v, y[3], c[1], t[3])
+		c[2], t[2] = madd2(m, 10998096788944562424, c[2], c[0])
+		c[1], c[0] = madd2(v, y[4], c[1], t[4])
+		c[2], t[3] = madd2(m, 8204665564953313070, c[2], c[0])
+		c[1], c[0] = madd2(v, y[5], c[1], t[5])
+		c[2], t[4] = madd2(m, 9694500593442880912, c[2], c[0])
+		c[1], c[0] = madd2(v, y[6], c[1], t[6])
+		c[2], t[5] = madd2(m, 274362232328168196, c[2], c[0])
+		c[1], c[0] = madd2(v, y[7], c[1], t[7])
+		c[2], t[6] = madd2(m, 8105254717682411801, c[2], c[0])
+		c[1], c[0] = madd2(v, y[8], c[1], t[8])
+		c[2], t[7] = madd2(m, 5945444129596489281, c[2], c[0])
+		c[1], c[0] = madd2(v, y[9], c[1], t[9])
+		c[2], t[8] = madd2(m, 13341377791855249032, c[2], c[0])
+		c[1], c[0] = madd2(v, y[10], c[1], t[10])
+		c[2], t[9] = madd2(m, 15098257552581525310, c[2], c[0])
+		c[1], c[0] = madd2(v, y[11], c[1], t[11])
+		t[11], t[10] = madd3(m, 81882988782276106, c[0], c[2], c[1])
+	}
+	{
+		// round 10
+		v := x[10]
+		c[1], c[0] = madd1(v, y[0], t[0])
+		m := c[0] * 744663313386281181
+		c[2] = madd0(m, 17626244516597989515, c[0])
+		c[1], c[0] = madd2(v, y[1], c[1], t[1])
+		c[2], t[0] = madd2(m, 16614129118623039618, c[2], c[0])
+		c[1], c[0] = madd2(v, y[2], c[1], t[2])
+		c[2], t[1] = madd2(m, 1588918198704579639, c[2], c[0])
+		c[1], c[0] = madd2(v, y[3], c[1], t[3])
+		c[2], t[2] = madd2(m, 10998096788944562424, c[2], c[0])
+		c[1], c[0] = madd2(v, y[4], c[1], t[4])
+		c[2], t[3] = madd2(m, 8204665564953313070, c[2], c[0])
+		c[1], c[0] = madd2(v, y[5], c[1], t[5])
+		c[2], t[4] = madd2(m, 9694500593442880912, c[2], c[0])
+		c[1], c[0] = madd2(v, y[6], c[1], t[6])
+		c[2], t[5] = madd2(m, 274362232328168196, c[2], c[0])
+		c[1], c[0] = madd2(v, y[7], c[1], t[7])
+		c[2], t[6] = madd2(m, 8105254717682411801, c[2], c[0])
+		c[1], c[0] = madd2(v, y[8], c[1], t[8])
+		c[2], t[7] = madd2(m, 5945444129596489281, c[2], c[0])
+		c[1], c[0] = madd2(v, y[9], c[1], t[9])
+		c[2], t[8] = madd2(m, 13341377791855249032, c[2], c[0])
+		c[1], c[0] = madd2(v, y[10], c[1], t[10])
+		c[2], t[9] = madd2(m, 15098257552581525310, c[2], c[0])
+		c[1], c[0] = madd2(v, y[11], c[1], t[11])
+		t[11], t[10] = madd3(m, 81882988782276106, c[0], c[2], c[1])
+	}
+	{
+		// round 11
+		v := x[11]
+		c[1], c[0] = madd1(v, y[0], t[0])
+		m := c[0] * 744663313386281181
+		c[2] = madd0(m, 17626244516597989515, c[0])
+		c[1], c[0] = madd2(v, y[1], c[1], t[1])
+		c[2], z[0] = madd2(m, 16614129118623039618, c[2], c[0])
+		c[1], c[0] = madd2(v, y[2], c[1], t[2])
+		c[2], z[1] = madd2(m, 1588918198704579639, c[2], c[0])
+		c[1], c[0] = madd2(v, y[3], c[1], t[3])
+		c[2], z[2] = madd2(m, 10998096788944562424, c[2], c[0])
+		c[1], c[0] = madd2(v, y[4], c[1], t[4])
+		c[2], z[3] = madd2(m, 8204665564953313070, c[2], c[0])
+		c[1], c[0] = madd2(v, y[5], c[1], t[5])
+		c[2], z[4] = madd2(m, 9694500593442880912, c[2], c[0])
+		c[1], c[0] = madd2(v, y[6], c[1], t[6])
+		c[2], z[5] = madd2(m, 274362232328168196, c[2], c[0])
+		c[1], c[0] = madd2(v, y[7], c[1], t[7])
+		c[2], z[6] = madd2(m, 8105254717682411801, c[2], c[0])
+		c[1], c[0] = madd2(v, y[8], c[1], t[8])
+		c[2], z[7] = madd2(m, 5945444129596489281, c[2], c[0])
+		c[1], c[0] = madd2(v, y[9], c[1], t[9])
+		c[2], z[8] = madd2(m, 13341377791855249032, c[2], c[0])
+		c[1], c[0] = madd2(v, y[10], c[1], t[10])
+		c[2], z[9] = madd2(m, 15098257552581525310, c[2], c[0])
+		c[1], c[0] = madd2(v, y[11], c[1], t[11])
+		z[11], z[10] = madd3(m, 81882988782276106, c[0], c[2], c[1])
+	}
+
+	// if z > q --> z -= q
+	// note: this is NOT constant time
+	if !(z[11] < 81882988782276106 || (z[11] == 81882988782276106 && (z[10] < 15098257552581525310 || (z[10] == 15098257552581525310 && (z[9] < 13341377791855249032 || (z[9] == 13341377791855249032 && (z[8] < 5945444129596489281 || (z[8] == 5945444129596489281 && (z[7] < 8105254717682411801 || (z[7] == 8105254717682411801 && (z[6] < 274362232328168196 || (z[6] == 274362232328168196 && (z[5] < 9694500593442880912 || (z[5] == 9694500593442880912 && (z[4] < 8204665564953313070 || (z[4] == 8204665564953313070 && (z[3] < 10998096788944562424 || (z[3] == 10998096788944562424 && (z[2] < 1588918198704579639 || (z[2] == 1588918198704579639 && (z[1] < 16614129118623039618 || (z[1] == 16614129118623039618 && (z[0] < 17626244516597989515))))))))))))))))))))))) {
+		var b uint64
+		z[0], b = bits.Sub64(z[0], 17626244516597989515, 0)
+		z[1], b = bits.Sub64(z[1], 16614129118623039618, b)
+		z[2], b = bits.Sub64(z[2], 1588918198704579639, b)
+		z[3], b = bits.Sub64(z[3], 10998096788944562424, b)
+		z[4], b = bits.Sub64(z[4], 8204665564953313070, b)
+		z[5], b = bits.Sub64(z[5], 9694500593442880912, b)
+		z[6], b = bits.Sub64(z[6], 274362232328168196, b)
+		z[7], b = bits.Sub64(z[7], 8105254717682411801, b)
+		z[8], b = bits.Sub64(z[8], 5945444129596489281, b)
+		z[9], b = bits.Sub64(z[9], 13341377791855249032, b)
+		z[10], b = bits.Sub64(z[10], 15098257552581525310, b)
+		z[11], _ = bits.Sub64(z[11], 81882988782276106, b)
+	}
+}
+
+func squareGeneric(z, x *fe) {
+
+	var t [24]uint64
+	var c, cc uint64
+
+	// off diagonal products
+	{
+		// row 0
+		v := x[0]
+		c, t[1] = bits.Mul64(v, x[1])
+		c, t[2] = madd1(v, x[2], c)
+		c, t[3] = madd1(v, x[3], c)
+		c, t[4] = madd1(v, x[4], c)
+		c, t[5] = madd1(v, x[5], c)
+		c, t[6] = madd1(v, x[6], c)
+		c, t[7] = madd1(v, x[7], c)
+		c, t[8] = madd1(v, x[8], c)
+		c, t[9] = madd1(v, x[9], c)
+		c, t[10] = madd1(v, x[10], c)
+		c, t[11] = madd1(v, x[11], c)
+		t[12] = c
+	}
+	{
+		// row 1
+		v := x[1]
+		c, t[3] = madd1(v, x[2], t[3])
+		c, t[4] = madd2(v, x[3], t[4], c)
+		c, t[5] = madd2(v, x[4], t[5], c)
+		c, t[6] = madd2(v, x[5], t[6], c)
+		c, t[7] = madd2(v, x[6], t[7], c)
+		c, t[8] = madd2(v, x[7], t[8], c)
+		c, t[9] = madd2(v, x[8], t[9], c)
+		c, t[10] = madd2(v, x[9], t[10], c)
+		c, t[11] = madd2(v, x[10], t[11], c)
+		c, t[12] = madd2(v, x[11], t[12], c)
+		t[13] = c
+	}
+	{
+		// row 2
+		v := x[2]
+		c, t[5] = madd1(v, x[3], t[5])
+		c, t[6] = madd2(v, x[4], t[6], c)
+		c, t[7] = madd2(v, x[5], t[7], c)
+		c, t[8] = madd2(v, x[6], t[8], c)
+		c, t[9] = madd2(v, x[7], t[9], c)
+		c, t[10] = madd2(v, x[8], t[10], c)
+		c, t[11] = madd2(v, x[9], t[11], c)
+		c, t[12] = madd2(v, x[10], t[12], c)
+		c, t[13] = madd2(v, x[11], t[13], c)
+		t[14] = c
+	}
+	{
+		// row 3
+		v := x[3]
+		c, t[7] = madd1(v, x[4], t[7])
+		c, t[8] = madd2(v, x[5], t[8], c)
+		c, t[9] = madd2(v, x[6], t[9], c)
+		c, t[10] = madd2(v, x[7], t[10], c)
+		c, t[11] = madd2(v, x[8], t[11], c)
+		c, t[12] = madd2(v, x[9], t[12], c)
+		c, t[13] = madd2(v, x[10], t[13], c)
+		c, t[14] = madd2(v, x[11], t[14], c)
+		t[15] = c
+	}
+	{
+		// row 4
+		v := x[4]
+		c, t[9] = madd1(v, x[5], t[9])
+		c, t[10] = madd2(v, x[6], t[10], c)
+		c, t[11] = madd2(v, x[7], t[11], c)
+		c, t[12] = madd2(v, x[8], t[12], c)
+		c, t[13] = madd2(v, x[9], t[13], c)
+		c, t[14] = madd2(v, x[10], t[14], c)
+		c, t[15] = madd2(v, x[11], t[15], c)
+		t[16] = c
+	}
+	{
+		// row 5
+		v := x[5]
+		c, t[11] = madd1(v, x[6], t[11])
+		c, t[12] = madd2(v, x[7], t[12], c)
+		c, t[13] = madd2(v, x[8], t[13], c)
+		c, t[14] = madd2(v, x[9], t[14], c)
+		c, t[15] = madd2(v, x[10], t[15], c)
+		c, t[16] = madd2(v, x[11], t[16], c)
+		t[17] = c
+	}
+	{
+		// row 6
+		v := x[6]
+		c, t[13] = madd1(v, x[7], t[13])
+		c, t[14] = madd2(v, x[8], t[14], c)
+		c, t[15] = madd2(v, x[9], t[15], c)
+		c, t[16] = madd2(v, x[10], t[16], c)
+		c, t[17] = madd2(v, x[11], t[17], c)
+		t[18] = c
+	}
+	{
+		// row 7
+		v := x[7]
+		c, t[15] = madd1(v, x[8], t[15])
+		c, t[16] = madd2(v, x[9], t[16], c)
+		c, t[17] = madd2(v, x[10], t[17], c)
+		c, t[18] = madd2(v, x[11], t[18], c)
+		t[19] = c
+	}
+	{
+		// row 8
+		v := x[8]
+		c, t[17] = madd1(v, x[9], t[17])
+		c, t[18] = madd2(v, x[10], t[18], c)
+		c, t[19] = madd2(v, x[11], t[19], c)
+		t[20] = c
+	}
+	{
+		// row 9
+		v := x[9]
+		c, t[19] = madd1(v, x[10], t[19])
+		c, t[20] = madd2(v, x[11], t[20], c)
+		t[21] = c
+	}
+	{
+		// row 10
+		v := x[10]
+		c, t[21] = madd1(v, x[11], t[21])
+		t[22] = c
+	}
+
+	// double and add diagonal
+	t[23] = t[22] >> 63
+	t[22] = t[22]<<1 | t[21]>>63
+	t[21] = t[21]<<1 | t[20]>>63
+	t[20] = t[20]<<1 | t[19]>>63
+	t[19] = t[19]<<1 | t[18]>>63
+	t[18] = t[18]<<1 | t[17]>>63
+	t[17] = t[17]<<1 | t[16]>>63
+	t[16] = t[16]<<1 | t[15]>>63
+	t[15] = t[15]<<1 | t[14]>>63
+	t[14] = t[14]<<1 | t[13]>>63
+	t[13] = t[13]<<1 | t[12]>>63
+	t[12] = t[12]<<1 | t[11]>>63
+	t[11] = t[11]<<1 | t[10]>>63
+	t[10] = t[10]<<1 | t[9]>>63
+	t[9] = t[9]<<1 | t[8]>>63
+	t[8] = t[8]<<1 | t[7]>>63
+	t[7] = t[7]<<1 | t[6]>>63
+	t[6] = t[6]<<1 | t[5]>>63
+	t[5] = t[5]<<1 | t[4]>>63
+	t[4] = t[4]<<1 | t[3]>>63
+	t[3] = t[3]<<1 | t[2]>>63
+	t[2] = t[2]<<1 | t[1]>>63
+	t[1] = t[1] << 1
+	c, t[0] = bits.Mul64(x[0], x[0])
+	t[1], cc = bits.Add64(t[1], c, 0)
+	c, lo := bits.Mul64(x[1], x[1])
+	t[2], cc = bits.Add64(t[2], lo, cc)
+	t[3], cc = bits.Add64(t[3], c, cc)
+	c, lo = bits.Mul64(x[2], x[2])
+	t[4], cc = bits.Add64(t[4], lo, cc)
+	t[5], cc = bits.Add64(t[5], c, cc)
+	c, lo = bits.Mul64(x[3], x[3])
+	t[6], cc = bits.Add64(t[6], lo, cc)
+	t[7], cc = bits.Add64(t[7], c, cc)
+	c, lo = bits.Mul64(x[4], x[4])
+	t[8], cc = bits.Add64(t[8], lo, cc)
+	t[9], cc = bits.Add64(t[9], c, cc)
+	c, lo = bits.Mul64(x[5], x[5])
+	t[10], cc = bits.Add64(t[10], lo, cc)
+	t[11], cc = bits.Add64(t[11], c, cc)
+	c, lo = bits.Mul64(x[6], x[6])
+	t[12], cc = bits.Add64(t[12], lo, cc)
+	t[13], cc = bits.Add64(t[13], c, cc)
+	c, lo = bits.Mul64(x[7], x[7])
+	t[14], cc = bits.Add64(t[14], lo, cc)
+	t[15], cc = bits.Add64(t[15], c, cc)
+	c, lo = bits.Mul64(x[8], x[8])
+	t[16], cc = bits.Add64(t[16], lo, cc)
+	t[17], cc = bits.Add64(t[17], c, cc)
+	c, lo = bits.Mul64(x[9], x[9])
+	t[18], cc = bits.Add64(t[18], lo, cc)
+	t[19], cc = bits.Add64(t[19], c, cc)
+	c, lo = bits.Mul64(x[10], x[10])
+	t[20], cc = bits.Add64(t[20], lo, cc)
+	t[21], cc = bits.Add64(t[21], c, cc)
+	c, lo = bits.Mul64(x[11], x[11])
+	t[22], cc = bits.Add64(t[22], lo, cc)
+	t[23], cc = bits.Add64(t[23], c, cc)
+
+	// montgomery reduction
+	cc = 0
+	{
+		// round 0
+		m := t[0] * 744663313386281181
+		c = madd0(m, 17626244516597989515, t[0])
+		c, t[1] = madd2(m, 16614129118623039618, t[1], c)
+		c, t[2] = madd2(m, 1588918198704579639, t[2], c)
+		c, t[3] = madd2(m, 10998096788944562424, t[3], c)
+		c, t[4] = madd2(m, 8204665564953313070, t[4], c)
+		c, t[5] = madd2(m, 9694500593442880912, t[5], c)
+		c, t[6] = madd2(m, 274362232328168196, t[6], c)
+		c, t[7] = madd2(m, 8105254717682411801, t[7], c)
+		c, t[8] = madd2(m, 5945444129596489281, t[8], c)
+		c, t[9] = madd2(m, 13341377791855249032, t[9], c)
+		c, t[10] = madd2(m, 15098257552581525310, t[10], c)
+		c, t[11] = madd2(m, 81882988782276106, t[11], c)
+		t[12], cc = bits.Add64(t[12], c, cc)
+	}
+	{
+		// round 1
+		m := t[1] * 744663313386281181
+		c = madd0(m, 17626244516597989515, t[1])
+		c, t[2] = madd2(m, 16614129118623039618, t[2], c)
+		c, t[3] = madd2(m, 1588918198704579639, t[3], c)
+		c, t[4] = madd2(m, 10998096788944562424, t[4], c)
+		c, t[5] = madd2(m, 8204665564953313070, t[5], c)
+		c, t[6] = madd2(m, 9694500593442880912, t[6], c)
+		c, t[7] = madd2(m, 274362232328168196, t[7], c)
+		c, t[8] = madd2(m, 8105254717682411801, t[8], c)
+		c, t[9] = madd2(m, 5945444129596489281, t[9], c)
+		c, t[10] = madd2(m, 13341377791855249032, t[10], c)
+		c, t[11] = madd2(m, 15098257552581525310, t[11], c)
+		c, t[12] = madd2(m, 81882988782276106, t[12], c)
+		t[13], cc = bits.Add64(t[13], c, cc)
+	}
+	{
+		// round 2
+		m := t[2] * 744663313386281181
+		c = madd0(m, 17626244516597989515, t[2])
+		c, t[3] = madd2(m, 16614129118623039618, t[3], c)
+		c, t[4] = madd2(m, 1588918198704579639, t[4], c)
+		c, t[5] = madd2(m, 10998096788944562424, t[5], c)
+		c, t[6] = madd2(m, 8204665564953313070, t[6], c)
+		c, t[7] = madd2(m, 9694500593442880912, t[7], c)
+		c, t[8] = madd2(m, 274362232328168196, t[8], c)
+		c, t[9] = madd2(m, 8105254717682411801, t[9], c)
+		c, t[10] = madd2(m, 5945444129596489281, t[10], c)
+		c, t[11] = madd2(m, 13341377791855249032, t[11], c)
+		c, t[12] = madd2(m, 15098257552581525310, t[12], c)
+		c, t[13] = madd2(m, 81882988782276106, t[13], c)
+		t[14], cc = bits.Add64(t[14], c, cc)
+	}
+	{
+		// round 3
+		m := t[3] * 744663313386281181
+		c = madd0(m, 17626244516597989515, t[3])
+		c, t[4] = madd2(m, 16614129118623039618, t[4], c)
+		c, t[5] = madd2(m, 1588918198704579639, t[5], c)
+		c, t[6] = madd2(m, 10998096788944562424, t[6], c)
+		c, t[7] = madd2(m, 8204665564953313070, t[7], c)
+		c, t[8] = madd2(m, 9694500593442880912, t[8], c)
+		c, t[9] = madd2(m, 274362232328168196, t[9], c)
+		c, t[10] = madd2(m, 8105254717682411801, t[10], c)
+		c, t[11] = madd2(m, 5945444129596489281, t[11], c)
+		c, t[12] = madd2(m, 13341377791855249032, t[12], c)
+		c, t[13] = madd2(m, 15098257552581525310, t[13], c)
+		c, t[14] = madd2(m, 81882988782276106, t[14], c)
+		t[15], cc = bits.Add64(t[15], c, cc)
+	}
+	{
+		// round 4
+		m := t[4] * 744663313386281181
+		c = madd0(m, 17626244516597989515, t[4])
+		c, t[5] = madd2(m, 16614129118623039618, t[5], c)
+		c, t[6] = madd2(m, 1588918198704579639, t[6], c)
+		c, t[7] = madd2(m, 10998096788944562424, t[7], c)
+		c, t[8] = madd2(m, 8204665564953313070, t[8], c)
+		c, t[9] = madd2(m, 9694500593442880912, t[9], c)
+		c, t[10] = madd2(m, 274362232328168196, t[10], c)
+		c, t[11] = madd2(m, 8105254717682411801, t[11], c)
+		c, t[12] = madd2(m, 5945444129596489281, t[12], c)
+		c, t[13] = madd2(m, 13341377791855249032, t[13], c)
+		c, t[14] = madd2(m, 15098257552581525310, t[14], c)
+		c, t[15] = madd2(m, 81882988782276106, t[15], c)
+		t[16], cc = bits.Add64(t[16], c, cc)
+	}
+	{
+		// round 5
+		m := t[5] * 744663313386281181
+		c = madd0(m, 17626244516597989515, t[5])
+		c, t[6] = madd2(m, 16614129118623039618, t[6], c)
+		c, t[7] = madd2(m, 1588918198704579639, t[7], c)
+		c, t[8] = madd2(m, 10998096788944562424, t[8], c)
+		c, t[9] = madd2(m, 8204665564953313070, t[9], c)
+		c, t[10] = madd2(m, 9694500593442880912, t[10], c)
+		c, t[11] = madd2(m, 274362232328168196, t[11], c)
+		c, t[12] = madd2(m, 8105254717682411801, t[12], c)
+		c, t[13] = madd2(m, 5945444129596489281, t[13], c)
+		c, t[14] = madd2(m, 13341377791855249032, t[14], c)
+		c, t[15] = madd2(m, 15098257552581525310, t[15], c)
+		c, t[16] = madd2(m, 81882988782276106, t[16], c)
+		t[17], cc = bits.Add64(t[17], c, cc)
+	}
+	{
+		// round 6
+		m := t[6] * 744663313386281181
+		c = madd0(m, 17626244516597989515, t[6])
+		c, t[7] = madd2(m, 16614129118623039618, t[7], c)
+		c, t[8] = madd2(m, 1588918198704579639, t[8], c)
+		c, t[9] = madd2(m, 10998096788944562424, t[9], c)
+		c, t[10] = madd2(m, 8204665564953313070, t[10], c)
+		c, t[11] = madd2(m, 9694500593442880912, t[11], c)
+		c, t[12] = madd2(m, 274362232328168196, t[12], c)
+		c, t[13] = madd2(m, 8105254717682411801, t[13], c)
+		c, t[14] = madd2(m, 5945444129596489281, t[14], c)
+		c, t[15] = madd2(m, 13341377791855249032, t[15], c)
+		c, t[16] = madd2(m, 15098257552581525310, t[16], c)
+		c, t[17] = madd2(m, 81882988782276106, t[17], c)
+		t[18], cc = bits.Add64(t[18], c, cc)
+	}
+	{
+		// round 7
+		m := t[7] * 744663313386281181
+		c = madd0(m, 17626244516597989515, t[7])
+		c, t[8] = madd2(m, 16614129118623039618, t[8], c)
+		c, t[9] = madd2(m, 1588918198704579639, t[9], c)
+		c, t[10] = madd2(m, 10998096788944562424, t[10], c)
+		c, t[11] = madd2(m, 8204665564953313070, t[11], c)
+		c, t[12] = madd2(m, 9694500593442880912, t[12], c)
+		c, t[13] = madd2(m, 274362232328168196, t[13], c)
+		c, t[14] = madd2(m, 8105254717682411801, t[14], c)
+		c, t[15] = madd2(m, 5945444129596489281, t[15], c)
+		c, t[16] = madd2(m, 13341377791855249032, t[16], c)
+		c, t[17] = madd2(m, 15098257552581525310, t[17], c)
+		c, t[18] = madd2(m, 81882988782276106, t[18], c)
+		t[19], cc = bits.Add64(t[19], c, cc)
+	}
+	{
+		// round 8
+		m := t[8] * 744663313386281181
+		c = madd0(m, 17626244516597989515, t[8])
+		c, t[9] = madd2(m, 16614129118623039618, t[9], c)
+		c, t[10] = madd2(m, 1588918198704579639, t[10], c)
+		c, t[11] = madd2(m, 10998096788944562424, t[11], c)
+		c, t[12] = madd2(m, 8204665564953313070, t[12], c)
+		c, t[13] = madd2(m, 9694500593442880912, t[13], c)
+		c, t[14] = madd2(m, 274362232328168196, t[14], c)
+		c, t[15] = madd2(m, 8105254717682411801, t[15], c)
+		c, t[16] = madd2(m, 5945444129596489281, t[16], c)
+		c, t[17] = madd2(m, 13341377791855249032, t[17], c)
+		c, t[18] = madd2(m, 15098257552581525310, t[18], c)
+		c, t[19] = madd2(m, 81882988782276106, t[19], c)
+		t[20], cc = bits.Add64(t[20], c, cc)
+	}
+	{
+		// round 9
+		m := t[9] * 744663313386281181
+		c = madd0(m, 17626244516597989515, t[9])
+		c, t[10] = madd2(m, 16614129118623039618, t[10], c)
+		c, t[11] = madd2(m, 1588918198704579639, t[11], c)
+		c, t[12] = madd2(m, 10998096788944562424, t[12], c)
+		c, t[13] = madd2(m, 8204665564953313070, t[13], c)
+		c, t[14] = madd2(m, 9694500593442880912, t[14], c)
+		c, t[15] = madd2(m, 274362232328168196, t[15], c)
+		c, t[16] = madd2(m, 8105254717682411801, t[16], c)
+		c, t[17] = madd2(m, 5945444129596489281, t[17], c)
+		c, t[18] = madd2(m, 13341377791855249032, t[18], c)
+		c, t[19] = madd2(m, 15098257552581525310, t[19], c)
+		c, t[20] = madd2(m, 81882988782276106, t[20], c)
+		t[21], cc = bits.Add64(t[21], c, cc)
+	}
+	{
+		// round 10
+		m := t[10] * 744663313386281181
+		c = madd0(m, 17626244516597989515, t[10])
+		c, t[11] = madd2(m, 16614129118623039618, t[11], c)
+		c, t[12] = madd2(m, 1588918198704579639, t[12], c)
+		c, t[13] = madd2(m, 10998096788944562424, t[13], c)
+		c, t[14] = madd2(m, 8204665564953313070, t[14], c)
+		c, t[15] = madd2(m, 9694500593442880912, t[15], c)
+		c, t[16] = madd2(m, 274362232328168196, t[16], c)
+		c, t[17] = madd2(m, 8105254717682411801, t[17], c)
+		c, t[18] = madd2(m, 5945444129596489281, t[18], c)
+		c, t[19] = madd2(m, 13341377791855249032, t[19], c)
+		c, t[20] = madd2(m, 15098257552581525310, t[20], c)
+		c, t[21] = madd2(m, 81882988782276106, t[21], c)
+		t[22], cc = bits.Add64(t[22], c, cc)
+	}
+	{
+		// round 11
+		m := t[11] * 744663313386281181
+		c = madd0(m, 17626244516597989515, t[11])
+		c, t[12] = madd2(m, 16614129118623039618, t[12], c)
+		c, t[13] = madd2(m, 1588918198704579639, t[13], c)
+		c, t[14] = madd2(m, 10998096788944562424, t[14], c)
+		c, t[15] = madd2(m, 8204665564953313070, t[15], c)
+		c, t[16] = madd2(m, 9694500593442880912, t[16], c)
+		c, t[17] = madd2(m, 274362232328168196, t[17], c)
+		c, t[18] = madd2(m, 8105254717682411801, t[18], c)
+		c, t[19] = madd2(m, 5945444129596489281, t[19], c)
+		c, t[20] = madd2(m, 13341377791855249032, t[20], c)
+		c, t[21] = madd2(m, 15098257552581525310, t[21], c)
+		c, t[22] = madd2(m, 81882988782276106, t[22], c)
+		t[23], cc = bits.Add64(t[23], c, cc)
+	}
+	copy(z[:], t[12:])
+
+	// if z > q --> z -= q
+	// note: this is NOT constant time
+	if !(z[11] < 81882988782276106 || (z[11] == 81882988782276106 && (z[10] < 15098257552581525310 || (z[10] == 15098257552581525310 && (z[9] < 13341377791855249032 || (z[9] == 13341377791855249032 && (z[8] < 5945444129596489281 || (z[8] == 5945444129596489281 && (z[7] < 8105254717682411801 || (z[7] == 8105254717682411801 && (z[6] < 274362232328168196 || (z[6] == 274362232328168196 && (z[5] < 9694500593442880912 || (z[5] == 9694500593442880912 && (z[4] < 8204665564953313070 || (z[4] == 8204665564953313070 && (z[3] < 10998096788944562424 || (z[3] == 10998096788944562424 && (z[2] < 1588918198704579639 || (z[2] == 1588918198704579639 && (z[1] < 16614129118623039618 || (z[1] == 16614129118623039618 && (z[0] < 17626244516597989515))))))))))))))))))))))) {
+		var b uint64
+		z[0], b = bits.Sub64(z[0], 17626244516597989515, 0)
+		z[1], b = bits.Sub64(z[1], 16614129118623039618, b)
+		z[2], b = bits.Sub64(z[2], 1588918198704579639, b)
+		z[3], b = bits.Sub64(z[3], 10998096788944562424, b)
+		z[4], b = bits.Sub64(z[4], 8204665564953313070, b)
+		z[5], b = bits.Sub64(z[5], 9694500593442880912, b)
+		z[6], b = bits.Sub64(z[6], 274362232328168196, b)
+		z[7], b = bits.Sub64(z[7], 8105254717682411801, b)
+		z[8], b = bits.Sub64(z[8], 5945444129596489281, b)
+		z[9], b = bits.Sub64(z[9], 13341377791855249032, b)
+		z[10], b = bits.Sub64(z[10], 15098257552581525310, b)
+		z[11], _ = bits.Sub64(z[11], 81882988782276106, b)
+	}
+}
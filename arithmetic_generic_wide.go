@@ -0,0 +1,471 @@
+package bw6
+
+import (
+	"math/bits"
+)
+
+func mulWideGeneric(z *wfe, x, y *fe) {
+
+	var c uint64
+	{
+		// round 0
+		v := x[0]
+		c, z[0] = bits.Mul64(v, y[0])
+		c, z[1] = madd1(v, y[1], c)
+		c, z[2] = madd1(v, y[2], c)
+		c, z[3] = madd1(v, y[3], c)
+		c, z[4] = madd1(v, y[4], c)
+		c, z[5] = madd1(v, y[5], c)
+		c, z[6] = madd1(v, y[6], c)
+		c, z[7] = madd1(v, y[7], c)
+		c, z[8] = madd1(v, y[8], c)
+		c, z[9] = madd1(v, y[9], c)
+		c, z[10] = madd1(v, y[10], c)
+		c, z[11] = madd1(v, y[11], c)
+		z[12] = c
+	}
+	{
+		// round 1
+		v := x[1]
+		c, z[1] = madd1(v, y[0], z[1])
+		c, z[2] = madd2(v, y[1], z[2], c)
+		c, z[3] = madd2(v, y[2], z[3], c)
+		c, z[4] = madd2(v, y[3], z[4], c)
+		c, z[5] = madd2(v, y[4], z[5], c)
+		c, z[6] = madd2(v, y[5], z[6], c)
+		c, z[7] = madd2(v, y[6], z[7], c)
+		c, z[8] = madd2(v, y[7], z[8], c)
+		c, z[9] = madd2(v, y[8], z[9], c)
+		c, z[10] = madd2(v, y[9], z[10], c)
+		c, z[11] = madd2(v, y[10], z[11], c)
+		c, z[12] = madd2(v, y[11], z[12], c)
+		z[13] = c
+	}
+	{
+		// round 2
+		v := x[2]
+		c, z[2] = madd1(v, y[0], z[2])
+		c, z[3] = madd2(v, y[1], z[3], c)
+		c, z[4] = madd2(v, y[2], z[4], c)
+		c, z[5] = madd2(v, y[3], z[5], c)
+		c, z[6] = madd2(v, y[4], z[6], c)
+		c, z[7] = madd2(v, y[5], z[7], c)
+		c, z[8] = madd2(v, y[6], z[8], c)
+		c, z[9] = madd2(v, y[7], z[9], c)
+		c, z[10] = madd2(v, y[8], z[10], c)
+		c, z[11] = madd2(v, y[9], z[11], c)
+		c, z[12] = madd2(v, y[10], z[12], c)
+		c, z[13] = madd2(v, y[11], z[13], c)
+		z[14] = c
+	}
+	{
+		// round 3
+		v := x[3]
+		c, z[3] = madd1(v, y[0], z[3])
+		c, z[4] = madd2(v, y[1], z[4], c)
+		c, z[5] = madd2(v, y[2], z[5], c)
+		c, z[6] = madd2(v, y[3], z[6], c)
+		c, z[7] = madd2(v, y[4], z[7], c)
+		c, z[8] = madd2(v, y[5], z[8], c)
+		c, z[9] = madd2(v, y[6], z[9], c)
+		c, z[10] = madd2(v, y[7], z[10], c)
+		c, z[11] = madd2(v, y[8], z[11], c)
+		c, z[12] = madd2(v, y[9], z[12], c)
+		c, z[13] = madd2(v, y[10], z[13], c)
+		c, z[14] = madd2(v, y[11], z[14], c)
+		z[15] = c
+	}
+	{
+		// round 4
+		v := x[4]
+		c, z[4] = madd1(v, y[0], z[4])
+		c, z[5] = madd2(v, y[1], z[5], c)
+		c, z[6] = madd2(v, y[2], z[6], c)
+		c, z[7] = madd2(v, y[3], z[7], c)
+		c, z[8] = madd2(v, y[4], z[8], c)
+		c, z[9] = madd2(v, y[5], z[9], c)
+		c, z[10] = madd2(v, y[6], z[10], c)
+		c, z[11] = madd2(v, y[7], z[11], c)
+		c, z[12] = madd2(v, y[8], z[12], c)
+		c, z[13] = madd2(v, y[9], z[13], c)
+		c, z[14] = madd2(v, y[10], z[14], c)
+		c, z[15] = madd2(v, y[11], z[15], c)
+		z[16] = c
+	}
+	{
+		// round 5
+		v := x[5]
+		c, z[5] = madd1(v, y[0], z[5])
+		c, z[6] = madd2(v, y[1], z[6], c)
+		c, z[7] = madd2(v, y[2], z[7], c)
+		c, z[8] = madd2(v, y[3], z[8], c)
+		c, z[9] = madd2(v, y[4], z[9], c)
+		c, z[10] = madd2(v, y[5], z[10], c)
+		c, z[11] = madd2(v, y[6], z[11], c)
+		c, z[12] = madd2(v, y[7], z[12], c)
+		c, z[13] = madd2(v, y[8], z[13], c)
+		c, z[14] = madd2(v, y[9], z[14], c)
+		c, z[15] = madd2(v, y[10], z[15], c)
+		c, z[16] = madd2(v, y[11], z[16], c)
+		z[17] = c
+	}
+	{
+		// round 6
+		v := x[6]
+		c, z[6] = madd1(v, y[0], z[6])
+		c, z[7] = madd2(v, y[1], z[7], c)
+		c, z[8] = madd2(v, y[2], z[8], c)
+		c, z[9] = madd2(v, y[3], z[9], c)
+		c, z[10] = madd2(v, y[4], z[10], c)
+		c, z[11] = madd2(v, y[5], z[11], c)
+		c, z[12] = madd2(v, y[6], z[12], c)
+		c, z[13] = madd2(v, y[7], z[13], c)
+		c, z[14] = madd2(v, y[8], z[14], c)
+		c, z[15] = madd2(v, y[9], z[15], c)
+		c, z[16] = madd2(v, y[10], z[16], c)
+		c, z[17] = madd2(v, y[11], z[17], c)
+		z[18] = c
+	}
+	{
+		// round 7
+		v := x[7]
+		c, z[7] = madd1(v, y[0], z[7])
+		c, z[8] = madd2(v, y[1], z[8], c)
+		c, z[9] = madd2(v, y[2], z[9], c)
+		c, z[10] = madd2(v, y[3], z[10], c)
+		c, z[11] = madd2(v, y[4], z[11], c)
+		c, z[12] = madd2(v, y[5], z[12], c)
+		c, z[13] = madd2(v, y[6], z[13], c)
+		c, z[14] = madd2(v, y[7], z[14], c)
+		c, z[15] = madd2(v, y[8], z[15], c)
+		c, z[16] = madd2(v, y[9], z[16], c)
+		c, z[17] = madd2(v, y[10], z[17], c)
+		c, z[18] = madd2(v, y[11], z[18], c)
+		z[19] = c
+	}
+	{
+		// round 8
+		v := x[8]
+		c, z[8] = madd1(v, y[0], z[8])
+		c, z[9] = madd2(v, y[1], z[9], c)
+		c, z[10] = madd2(v, y[2], z[10], c)
+		c, z[11] = madd2(v, y[3], z[11], c)
+		c, z[12] = madd2(v, y[4], z[12], c)
+		c, z[13] = madd2(v, y[5], z[13], c)
+		c, z[14] = madd2(v, y[6], z[14], c)
+		c, z[15] = madd2(v, y[7], z[15], c)
+		c, z[16] = madd2(v, y[8], z[16], c)
+		c, z[17] = madd2(v, y[9], z[17], c)
+		c, z[18] = madd2(v, y[10], z[18], c)
+		c, z[19] = madd2(v, y[11], z[19], c)
+		z[20] = c
+	}
+	{
+		// round 9
+		v := x[9]
+		c, z[9] = madd1(v, y[0], z[9])
+		c, z[10] = madd2(v, y[1], z[10], c)
+		c, z[11] = madd2(v, y[2], z[11], c)
+		c, z[12] = madd2(v, y[3], z[12], c)
+		c, z[13] = madd2(v, y[4], z[13], c)
+		c, z[14] = madd2(v, y[5], z[14], c)
+		c, z[15] = madd2(v, y[6], z[15], c)
+		c, z[16] = madd2(v, y[7], z[16], c)
+		c, z[17] = madd2(v, y[8], z[17], c)
+		c, z[18] = madd2(v, y[9], z[18], c)
+		c, z[19] = madd2(v, y[10], z[19], c)
+		c, z[20] = madd2(v, y[11], z[20], c)
+		z[21] = c
+	}
+	{
+		// round 10
+		v := x[10]
+		c, z[10] = madd1(v, y[0], z[10])
+		c, z[11] = madd2(v, y[1], z[11], c)
+		c, z[12] = madd2(v, y[2], z[12], c)
+		c, z[13] = madd2(v, y[3], z[13], c)
+		c, z[14] = madd2(v, y[4], z[14], c)
+		c, z[15] = madd2(v, y[5], z[15], c)
+		c, z[16] = madd2(v, y[6], z[16], c)
+		c, z[17] = madd2(v, y[7], z[17], c)
+		c, z[18] = madd2(v, y[8], z[18], c)
+		c, z[19] = madd2(v, y[9], z[19], c)
+		c, z[20] = madd2(v, y[10], z[20], c)
+		c, z[21] = madd2(v, y[11], z[21], c)
+		z[22] = c
+	}
+	{
+		// round 11
+		v := x[11]
+		c, z[11] = madd1(v, y[0], z[11])
+		c, z[12] = madd2(v, y[1], z[12], c)
+		c, z[13] = madd2(v, y[2], z[13], c)
+		c, z[14] = madd2(v, y[3], z[14], c)
+		c, z[15] = madd2(v, y[4], z[15], c)
+		c, z[16] = madd2(v, y[5], z[16], c)
+		c, z[17] = madd2(v, y[6], z[17], c)
+		c, z[18] = madd2(v, y[7], z[18], c)
+		c, z[19] = madd2(v, y[8], z[19], c)
+		c, z[20] = madd2(v, y[9], z[20], c)
+		c, z[21] = madd2(v, y[10], z[21], c)
+		c, z[22] = madd2(v, y[11], z[22], c)
+		z[23] = c
+	}
+}
+
+func montRedGeneric(z *fe, x *wfe) {
+
+	t := *x
+	var c, cc uint64
+	{
+		// round 0
+		m := t[0] * 744663313386281181
+		c = madd0(m, 17626244516597989515, t[0])
+		c, t[1] = madd2(m, 16614129118623039618, t[1], c)
+		c, t[2] = madd2(m, 1588918198704579639, t[2], c)
+		c, t[3] = madd2(m, 10998096788944562424, t[3], c)
+		c, t[4] = madd2(m, 8204665564953313070, t[4], c)
+		c, t[5] = madd2(m, 9694500593442880912, t[5], c)
+		c, t[6] = madd2(m, 274362232328168196, t[6], c)
+		c, t[7] = madd2(m, 8105254717682411801, t[7], c)
+		c, t[8] = madd2(m, 5945444129596489281, t[8], c)
+		c, t[9] = madd2(m, 13341377791855249032, t[9], c)
+		c, t[10] = madd2(m, 15098257552581525310, t[10], c)
+		c, t[11] = madd2(m, 81882988782276106, t[11], c)
+		t[12], cc = bits.Add64(t[12], c, cc)
+	}
+	{
+		// round 1
+		m := t[1] * 744663313386281181
+		c = madd0(m, 17626244516597989515, t[1])
+		c, t[2] = madd2(m, 16614129118623039618, t[2], c)
+		c, t[3] = madd2(m, 1588918198704579639, t[3], c)
+		c, t[4] = madd2(m, 10998096788944562424, t[4], c)
+		c, t[5] = madd2(m, 8204665564953313070, t[5], c)
+		c, t[6] = madd2(m, 9694500593442880912, t[6], c)
+		c, t[7] = madd2(m, 274362232328168196, t[7], c)
+		c, t[8] = madd2(m, 8105254717682411801, t[8], c)
+		c, t[9] = madd2(m, 5945444129596489281, t[9], c)
+		c, t[10] = madd2(m, 13341377791855249032, t[10], c)
+		c, t[11] = madd2(m, 15098257552581525310, t[11], c)
+		c, t[12] = madd2(m, 81882988782276106, t[12], c)
+		t[13], cc = bits.Add64(t[13], c, cc)
+	}
+	{
+		// round 2
+		m := t[2] * 744663313386281181
+		c = madd0(m, 17626244516597989515, t[2])
+		c, t[3] = madd2(m, 16614129118623039618, t[3], c)
+		c, t[4] = madd2(m, 1588918198704579639, t[4], c)
+		c, t[5] = madd2(m, 10998096788944562424, t[5], c)
+		c, t[6] = madd2(m, 8204665564953313070, t[6], c)
+		c, t[7] = madd2(m, 9694500593442880912, t[7], c)
+		c, t[8] = madd2(m, 274362232328168196, t[8], c)
+		c, t[9] = madd2(m, 8105254717682411801, t[9], c)
+		c, t[10] = madd2(m, 5945444129596489281, t[10], c)
+		c, t[11] = madd2(m, 13341377791855249032, t[11], c)
+		c, t[12] = madd2(m, 15098257552581525310, t[12], c)
+		c, t[13] = madd2(m, 81882988782276106, t[13], c)
+		t[14], cc = bits.Add64(t[14], c, cc)
+	}
+	{
+		// round 3
+		m := t[3] * 744663313386281181
+		c = madd0(m, 17626244516597989515, t[3])
+		c, t[4] = madd2(m, 16614129118623039618, t[4], c)
+		c, t[5] = madd2(m, 1588918198704579639, t[5], c)
+		c, t[6] = madd2(m, 10998096788944562424, t[6], c)
+		c, t[7] = madd2(m, 8204665564953313070, t[7], c)
+		c, t[8] = madd2(m, 9694500593442880912, t[8], c)
+		c, t[9] = madd2(m, 274362232328168196, t[9], c)
+		c, t[10] = madd2(m, 8105254717682411801, t[10], c)
+		c, t[11] = madd2(m, 5945444129596489281, t[11], c)
+		c, t[12] = madd2(m, 13341377791855249032, t[12], c)
+		c, t[13] = madd2(m, 15098257552581525310, t[13], c)
+		c, t[14] = madd2(m, 81882988782276106, t[14], c)
+		t[15], cc = bits.Add64(t[15], c, cc)
+	}
+	{
+		// round 4
+		m := t[4] * 744663313386281181
+		c = madd0(m, 17626244516597989515, t[4])
+		c, t[5] = madd2(m, 16614129118623039618, t[5], c)
+		c, t[6] = madd2(m, 1588918198704579639, t[6], c)
+		c, t[7] = madd2(m, 10998096788944562424, t[7], c)
+		c, t[8] = madd2(m, 8204665564953313070, t[8], c)
+		c, t[9] = madd2(m, 9694500593442880912, t[9], c)
+		c, t[10] = madd2(m, 274362232328168196, t[10], c)
+		c, t[11] = madd2(m, 8105254717682411801, t[11], c)
+		c, t[12] = madd2(m, 5945444129596489281, t[12], c)
+		c, t[13] = madd2(m, 13341377791855249032, t[13], c)
+		c, t[14] = madd2(m, 15098257552581525310, t[14], c)
+		c, t[15] = madd2(m, 81882988782276106, t[15], c)
+		t[16], cc = bits.Add64(t[16], c, cc)
+	}
+	{
+		// round 5
+		m := t[5] * 744663313386281181
+		c = madd0(m, 17626244516597989515, t[5])
+		c, t[6] = madd2(m, 16614129118623039618, t[6], c)
+		c, t[7] = madd2(m, 1588918198704579639, t[7], c)
+		c, t[8] = madd2(m, 10998096788944562424, t[8], c)
+		c, t[9] = madd2(m, 8204665564953313070, t[9], c)
+		c, t[10] = madd2(m, 9694500593442880912, t[10], c)
+		c, t[11] = madd2(m, 274362232328168196, t[11], c)
+		c, t[12] = madd2(m, 8105254717682411801, t[12], c)
+		c, t[13] = madd2(m, 5945444129596489281, t[13], c)
+		c, t[14] = madd2(m, 13341377791855249032, t[14], c)
+		c, t[15] = madd2(m, 15098257552581525310, t[15], c)
+		c, t[16] = madd2(m, 81882988782276106, t[16], c)
+		t[17], cc = bits.Add64(t[17], c, cc)
+	}
+	{
+		// round 6
+		m := t[6] * 744663313386281181
+		c = madd0(m, 17626244516597989515, t[6])
+		c, t[7] = madd2(m, 16614129118623039618, t[7], c)
+		c, t[8] = madd2(m, 1588918198704579639, t[8], c)
+		c, t[9] = madd2(m, 10998096788944562424, t[9], c)
+		c, t[10] = madd2(m, 8204665564953313070, t[10], c)
+		c, t[11] = madd2(m, 9694500593442880912, t[11], c)
+		c, t[12] = madd2(m, 274362232328168196, t[12], c)
+		c, t[13] = madd2(m, 8105254717682411801, t[13], c)
+		c, t[14] = madd2(m, 5945444129596489281, t[14], c)
+		c, t[15] = madd2(m, 13341377791855249032, t[15], c)
+		c, t[16] = madd2(m, 15098257552581525310, t[16], c)
+		c, t[17] = madd2(m, 81882988782276106, t[17], c)
+		t[18], cc = bits.Add64(t[18], c, cc)
+	}
+	{
+		// round 7
+		m := t[7] * 744663313386281181
+		c = madd0(m, 17626244516597989515, t[7])
+		c, t[8] = madd2(m, 16614129118623039618, t[8], c)
+		c, t[9] = madd2(m, 1588918198704579639, t[9], c)
+		c, t[10] = madd2(m, 10998096788944562424, t[10], c)
+		c, t[11] = madd2(m, 8204665564953313070, t[11], c)
+		c, t[12] = madd2(m, 9694500593442880912, t[12], c)
+		c, t[13] = madd2(m, 274362232328168196, t[13], c)
+		c, t[14] = madd2(m, 8105254717682411801, t[14], c)
+		c, t[15] = madd2(m, 5945444129596489281, t[15], c)
+		c, t[16] = madd2(m, 13341377791855249032, t[16], c)
+		c, t[17] = madd2(m, 15098257552581525310, t[17], c)
+		c, t[18] = madd2(m, 81882988782276106, t[18], c)
+		t[19], cc = bits.Add64(t[19], c, cc)
+	}
+	{
+		// round 8
+		m := t[8] * 744663313386281181
+		c = madd0(m, 17626244516597989515, t[8])
+		c, t[9] = madd2(m, 16614129118623039618, t[9], c)
+		c, t[10] = madd2(m, 1588918198704579639, t[10], c)
+		c, t[11] = madd2(m, 10998096788944562424, t[11], c)
+		c, t[12] = madd2(m, 8204665564953313070, t[12], c)
+		c, t[13] = madd2(m, 9694500593442880912, t[13], c)
+		c, t[14] = madd2(m, 274362232328168196, t[14], c)
+		c, t[15] = madd2(m, 8105254717682411801, t[15], c)
+		c, t[16] = madd2(m, 5945444129596489281, t[16], c)
+		c, t[17] = madd2(m, 13341377791855249032, t[17], c)
+		c, t[18] = madd2(m, 15098257552581525310, t[18], c)
+		c, t[19] = madd2(m, 81882988782276106, t[19], c)
+		t[20], cc = bits.Add64(t[20], c, cc)
+	}
+	{
+		// round 9
+		m := t[9] * 744663313386281181
+		c = madd0(m, 17626244516597989515, t[9])
+		c, t[10] = madd2(m, 16614129118623039618, t[10], c)
+		c, t[11] = madd2(m, 1588918198704579639, t[11], c)
+		c, t[12] = madd2(m, 10998096788944562424, t[12], c)
+		c, t[13] = madd2(m, 8204665564953313070, t[13], c)
+		c, t[14] = madd2(m, 9694500593442880912, t[14], c)
+		c, t[15] = madd2(m, 274362232328168196, t[15], c)
+		c, t[16] = madd2(m, 8105254717682411801, t[16], c)
+		c, t[17] = madd2(m, 5945444129596489281, t[17], c)
+		c, t[18] = madd2(m, 13341377791855249032, t[18], c)
+		c, t[19] = madd2(m, 15098257552581525310, t[19], c)
+		c, t[20] = madd2(m, 81882988782276106, t[20], c)
+		t[21], cc = bits.Add64(t[21], c, cc)
+	}
+	{
+		// round 10
+		m := t[10] * 744663313386281181
+		c = madd0(m, 17626244516597989515, t[10])
+		c, t[11] = madd2(m, 16614129118623039618, t[11], c)
+		c, t[12] = madd2(m, 1588918198704579639, t[12], c)
+		c, t[13] = madd2(m, 10998096788944562424, t[13], c)
+		c, t[14] = madd2(m, 8204665564953313070, t[14], c)
+		c, t[15] = madd2(m, 9694500593442880912, t[15], c)
+		c, t[16] = madd2(m, 274362232328168196, t[16], c)
+		c, t[17] = madd2(m, 8105254717682411801, t[17], c)
+		c, t[18] = madd2(m, 5945444129596489281, t[18], c)
+		c, t[19] = madd2(m, 13341377791855249032, t[19], c)
+		c, t[20] = madd2(m, 15098257552581525310, t[20], c)
+		c, t[21] = madd2(m, 81882988782276106, t[21], c)
+		t[22], cc = bits.Add64(t[22], c, cc)
+	}
+	{
+		// round 11
+		m := t[11] * 744663313386281181
+		c = madd0(m, 17626244516597989515, t[11])
+		c, t[12] = madd2(m, 16614129118623039618, t[12], c)
+		c, t[13] = madd2(m, 1588918198704579639, t[13], c)
+		c, t[14] = madd2(m, 10998096788944562424, t[14], c)
+		c, t[15] = madd2(m, 8204665564953313070, t[15], c)
+		c, t[16] = madd2(m, 9694500593442880912, t[16], c)
+		c, t[17] = madd2(m, 274362232328168196, t[17], c)
+		c, t[18] = madd2(m, 8105254717682411801, t[18], c)
+		c, t[19] = madd2(m, 5945444129596489281, t[19], c)
+		c, t[20] = madd2(m, 13341377791855249032, t[20], c)
+		c, t[21] = madd2(m, 15098257552581525310, t[21], c)
+		c, t[22] = madd2(m, 81882988782276106, t[22], c)
+		t[23], cc = bits.Add64(t[23], c, cc)
+	}
+	copy(z[:], t[12:])
+
+	// if z > q --> z -= q
+	// note: this is NOT constant time
+	if !(z[11] < 81882988782276106 || (z[11] == 81882988782276106 && (z[10] < 15098257552581525310 || (z[10] == 15098257552581525310 && (z[9] < 13341377791855249032 || (z[9] == 13341377791855249032 && (z[8] < 5945444129596489281 || (z[8] == 5945444129596489281 && (z[7] < 8105254717682411801 || (z[7] == 8105254717682411801 && (z[6] < 274362232328168196 || (z[6] == 274362232328168196 && (z[5] < 9694500593442880912 || (z[5] == 9694500593442880912 && (z[4] < 8204665564953313070 || (z[4] == 8204665564953313070 && (z[3] < 10998096788944562424 || (z[3] == 10998096788944562424 && (z[2] < 1588918198704579639 || (z[2] == 1588918198704579639 && (z[1] < 16614129118623039618 || (z[1] == 16614129118623039618 && (z[0] < 17626244516597989515))))))))))))))))))))))) {
+		var b uint64
+		z[0], b = bits.Sub64(z[0], 17626244516597989515, 0)
+		z[1], b = bits.Sub64(z[1], 16614129118623039618, b)
+		z[2], b = bits.Sub64(z[2], 1588918198704579639, b)
+		z[3], b = bits.Sub64(z[3], 10998096788944562424, b)
+		z[4], b = bits.Sub64(z[4], 8204665564953313070, b)
+		z[5], b = bits.Sub64(z[5], 9694500593442880912, b)
+		z[6], b = bits.Sub64(z[6], 274362232328168196, b)
+		z[7], b = bits.Sub64(z[7], 8105254717682411801, b)
+		z[8], b = bits.Sub64(z[8], 5945444129596489281, b)
+		z[9], b = bits.Sub64(z[9], 13341377791855249032, b)
+		z[10], b = bits.Sub64(z[10], 15098257552581525310, b)
+		z[11], _ = bits.Sub64(z[11], 81882988782276106, b)
+	}
+}
+
+func waddGeneric(z, x, y *wfe) {
+	var c uint64
+	for i := 0; i < len(z); i++ {
+		z[i], c = bits.Add64(x[i], y[i], c)
+	}
+}
+
+func wdoubleGeneric(z, x *wfe) {
+	var c uint64
+	for i := 0; i < len(z); i++ {
+		z[i], c = bits.Add64(x[i], x[i], c)
+	}
+}
+
+func wsubGeneric(z, x, y *wfe) {
+	var b uint64
+	for i := 0; i < len(z); i++ {
+		z[i], b = bits.Sub64(x[i], y[i], b)
+	}
+	if b != 0 {
+		// add q * 2^768
+		var c uint64
+		for i := 0; i < fpNumberOfLimbs; i++ {
+			z[i+fpNumberOfLimbs], c = bits.Add64(z[i+fpNumberOfLimbs], modulus[i], c)
+		}
+	}
+}
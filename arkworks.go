@@ -0,0 +1,252 @@
+package bw6
+
+import (
+	"errors"
+)
+
+// Encoding compatible with arkworks CanonicalSerialize for BW6-761 (ark-bw6-761).
+// Field elements are encoded in little-endian form.
+// Point flags are placed in the most significant bits of the last byte of the flagged coordinate.
+// Compressed points carry x coordinate only, uncompressed points carry x and y coordinates
+// where flags are attached to y coordinate. Infinity is encoded as zero coordinates with infinity flag.
+
+const (
+	arkworksYIsNegative = 1 << 7
+	arkworksInfinity    = 1 << 6
+	arkworksFlagMask    = arkworksYIsNegative | arkworksInfinity
+)
+
+func reverseBytes(in []byte) []byte {
+	out := make([]byte, len(in))
+	for i := 0; i < len(in); i++ {
+		out[i] = in[len(in)-1-i]
+	}
+	return out
+}
+
+func fromBytesLE(in []byte) (*fe, error) {
+	return fromBytes(reverseBytes(in))
+}
+
+func toBytesLE(e *fe) []byte {
+	return reverseBytes(toBytes(e))
+}
+
+// fromBytesLEWithFlags decodes a little-endian field element where flags are placed in the top bits.
+func fromBytesLEWithFlags(in []byte) (*fe, byte, error) {
+	if len(in) != fpByteSize {
+		return nil, 0, errors.New("input string length must be equal to 96 bytes")
+	}
+	flags := in[fpByteSize-1] & arkworksFlagMask
+	if flags == arkworksFlagMask {
+		return nil, 0, errors.New("infinity and sign flags must not be set together")
+	}
+	buf := make([]byte, fpByteSize)
+	copy(buf, in)
+	buf[fpByteSize-1] &= ^byte(arkworksFlagMask)
+	e, err := fromBytesLE(buf)
+	if err != nil {
+		return nil, 0, err
+	}
+	return e, flags, nil
+}
+
+func (g *group) fromArkworks(in []byte) (*point, error) {
+	switch len(in) {
+	case fpByteSize:
+		return g.fromArkworksCompressed(in)
+	case 2 * fpByteSize:
+		return g.fromArkworksUncompressed(in)
+	}
+	return nil, errors.New("input string length must be equal to 96 or 192 bytes")
+}
+
+func (g *group) fromArkworksCompressed(in []byte) (*point, error) {
+	x, flags, err := fromBytesLEWithFlags(in)
+	if err != nil {
+		return nil, err
+	}
+	if flags&arkworksInfinity != 0 {
+		if !x.isZero() {
+			return nil, errors.New("invalid infinity encoding")
+		}
+		return new(point).zero(), nil
+	}
+	negative := flags&arkworksYIsNegative != 0
+	y := new(fe)
+	square(y, x)
+	mul(y, y, x)
+	addAssign(y, g.b)
+	if !sqrt(y, y) {
+		return nil, errors.New("point is not on curve")
+	}
+	if y.isZero() && negative {
+		return nil, errors.New("sign flag must not be set for zero y coordinate")
+	}
+	if y.signBE() == negative {
+		neg(y, y)
+	}
+	z := new(fe).one()
+	return &point{*x, *y, *z}, nil
+}
+
+func (g *group) fromArkworksUncompressed(in []byte) (*point, error) {
+	x, err := fromBytesLE(in[:fpByteSize])
+	if err != nil {
+		return nil, err
+	}
+	// sign flag is redundant in uncompressed form and ignored as arkworks does
+	y, flags, err := fromBytesLEWithFlags(in[fpByteSize:])
+	if err != nil {
+		return nil, err
+	}
+	if flags&arkworksInfinity != 0 {
+		if !x.isZero() || !y.isZero() {
+			return nil, errors.New("invalid infinity encoding")
+		}
+		return new(point).zero(), nil
+	}
+	z := new(fe).one()
+	p := &point{*x, *y, *z}
+	if !g.isOnCurve(p) {
+		return nil, errors.New("point is not on curve")
+	}
+	return p, nil
+}
+
+// arkworksFlags expects the point in affine form.
+func (g *group) arkworksFlags(p *point) byte {
+	if g.isZero(p) {
+		return arkworksInfinity
+	}
+	if !p[1].signBE() {
+		return arkworksYIsNegative
+	}
+	return 0
+}
+
+func (g *group) toArkworks(p *point) []byte {
+	out := make([]byte, 2*fpByteSize)
+	if !g.isZero(p) {
+		g.affine(p, p)
+		copy(out[:fpByteSize], toBytesLE(&p[0]))
+		copy(out[fpByteSize:], toBytesLE(&p[1]))
+	}
+	out[2*fpByteSize-1] |= g.arkworksFlags(p)
+	return out
+}
+
+func (g *group) toArkworksCompressed(p *point) []byte {
+	out := make([]byte, fpByteSize)
+	if !g.isZero(p) {
+		g.affine(p, p)
+		copy(out, toBytesLE(&p[0]))
+	}
+	out[fpByteSize-1] |= g.arkworksFlags(p)
+	return out
+}
+
+// FromArkworks constructs a new G1 point given arkworks encoded input.
+// Compressed (96 bytes) and uncompressed (192 bytes) forms are distinguished by the input length.
+// As arkworks validates by default the point is checked to be in correct subgroup.
+func (g *G1) FromArkworks(in []byte) (*PointG1, error) {
+	p, err := g.FromArkworksUnchecked(in)
+	if err != nil {
+		return nil, err
+	}
+	if !g.InCorrectSubgroup(p) {
+		return nil, errors.New("point is not in correct subgroup")
+	}
+	return p, nil
+}
+
+// FromArkworksUnchecked constructs a new G1 point as FromArkworks without the subgroup check
+// which corresponds to deserialization with Validate::No in arkworks. Point is still checked to be on curve.
+func (g *G1) FromArkworksUnchecked(in []byte) (*PointG1, error) {
+	p, err := g.fromArkworks(in)
+	if err != nil {
+		return nil, err
+	}
+	return (*PointG1)(p), nil
+}
+
+// ToArkworks serializes a G1 point into arkworks uncompressed form.
+func (g *G1) ToArkworks(p *PointG1) []byte {
+	return g.toArkworks((*point)(p))
+}
+
+// ToArkworksCompressed serializes a G1 point into arkworks compressed form.
+func (g *G1) ToArkworksCompressed(p *PointG1) []byte {
+	return g.toArkworksCompressed((*point)(p))
+}
+
+// FromArkworks constructs a new G2 point given arkworks encoded input.
+// Compressed (96 bytes) and uncompressed (192 bytes) forms are distinguished by the input length.
+// As arkworks validates by default the point is checked to be in correct subgroup.
+func (g *G2) FromArkworks(in []byte) (*PointG2, error) {
+	p, err := g.FromArkworksUnchecked(in)
+	if err != nil {
+		return nil, err
+	}
+	if !g.InCorrectSubgroup(p) {
+		return nil, errors.New("point is not in correct subgroup")
+	}
+	return p, nil
+}
+
+// FromArkworksUnchecked constructs a new G2 point as FromArkworks without the subgroup check
+// which corresponds to deserialization with Validate::No in arkworks. Point is still checked to be on curve.
+func (g *G2) FromArkworksUnchecked(in []byte) (*PointG2, error) {
+	p, err := g.fromArkworks(in)
+	if err != nil {
+		return nil, err
+	}
+	return (*PointG2)(p), nil
+}
+
+// ToArkworks serializes a G2 point into arkworks uncompressed form.
+func (g *G2) ToArkworks(p *PointG2) []byte {
+	return g.toArkworks((*point)(p))
+}
+
+// ToArkworksCompressed serializes a G2 point into arkworks compressed form.
+func (g *G2) ToArkworksCompressed(p *PointG2) []byte {
+	return g.toArkworksCompressed((*point)(p))
+}
+
+// FrFromArkworks constructs a scalar field element from 48 bytes little-endian input.
+// Input is expected to be in canonical form that is less than the group order.
+func FrFromArkworks(in []byte) (*Fr, error) {
+	if len(in) != frByteSize {
+		return nil, errors.New("input string length must be equal to 48 bytes")
+	}
+	return FrFromBytes(reverseBytes(in))
+}
+
+// ToArkworks serializes the element into 48 bytes in little-endian canonical form
+func (e *Fr) ToArkworks() []byte {
+	return reverseBytes(e.ToBytes())
+}
+
+// FromArkworks expects 576 byte arkworks encoded input and returns target group element.
+// Coefficients are ordered as in FromBytes and each one is encoded in little-endian form.
+// FromArkworks returns error if given element is not on correct subgroup.
+func (g *GT) FromArkworks(in []byte) (*E, error) {
+	if len(in) != 6*fpByteSize {
+		return nil, errors.New("input string length must be equal to 576 bytes")
+	}
+	buf := make([]byte, 6*fpByteSize)
+	for i := 0; i < 6; i++ {
+		copy(buf[i*fpByteSize:], reverseBytes(in[i*fpByteSize:(i+1)*fpByteSize]))
+	}
+	return g.FromBytes(buf)
+}
+
+// ToArkworks serializes target group element into arkworks form.
+func (g *GT) ToArkworks(e *E) []byte {
+	out := g.ToBytes(e)
+	for i := 0; i < 6; i++ {
+		copy(out[i*fpByteSize:], reverseBytes(out[i*fpByteSize:(i+1)*fpByteSize]))
+	}
+	return out
+}
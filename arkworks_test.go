@@ -0,0 +1,268 @@
+package bw6
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+)
+
+// Vectors follow ark-bw6-761 v0.4 CanonicalSerialize layout.
+// Points are generators which are equal to G1_GENERATOR_X/Y and G2_GENERATOR_X/Y of ark-bw6-761
+// and their negations, target group vector is e(G1, G2).
+// Encodings are computed with an implementation of the layout independent of this package,
+// not with arkworks itself. Generator coordinates are cross checked against gnark-crypto v0.12.1
+// while point encodings, scalar encodings and especially the target group vector are not checked
+// against arkworks yet. testdata/arkworks prints the same vectors with ark-bw6-761 and
+// its output is expected to replace the ones below together with this note.
+
+var arkworksG1Vectors = []struct {
+	neg          bool
+	compressed   []byte
+	uncompressed []byte
+}{
+	{
+		false,
+		fromHex(0,
+			"3db4e566aff388403f60afa6ac285905823e135603dd50677fa20c289a8f75037109eac9a01fd75b909b7247ce547aa146e7c294d2fcdb11ac2055c1fa7f0179c76ff5854bc505eef0271b55b7cfa0e6aebe77a498ce77b2c890a10e025b0701",
+		),
+		fromHex(0,
+			"3db4e566aff388403f60afa6ac285905823e135603dd50677fa20c289a8f75037109eac9a01fd75b909b7247ce547aa146e7c294d2fcdb11ac2055c1fa7f0179c76ff5854bc505eef0271b55b7cfa0e6aebe77a498ce77b2c890a10e025b0701",
+			"6353e9b42d8ffcbaa1d2200bbeb21cad93fbd0ca1981b0b2533205b341f19d9fd4cdc26f0bb93fbe554c7a71315d68cc06b8b57117fab8c5ba0d7eaff1095926a373e5a2d248731ac69e4c888925950f422acc457b63fde674c56f0a4eb85800",
+		),
+	},
+	{
+		true,
+		fromHex(0,
+			"3db4e566aff388403f60afa6ac285905823e135603dd50677fa20c289a8f75037109eac9a01fd75b909b7247ce547aa146e7c294d2fcdb11ac2055c1fa7f0179c76ff5854bc505eef0271b55b7cfa0e6aebe77a498ce77b2c890a10e025b0781",
+		),
+		fromHex(0,
+			"3db4e566aff388403f60afa6ac285905823e135603dd50677fa20c289a8f75037109eac9a01fd75b909b7247ce547aa146e7c294d2fcdb11ac2055c1fa7f0179c76ff5854bc505eef0271b55b7cfa0e6aebe77a498ce77b2c890a10e025b0701",
+			"28ad164bd270a039e12ddf64aa8b7439a4a81f2095775c63a47662a3802503f959312904d11a9db33ab17fa1bb6b21bafd6afeb3e7c0153e5edb0636479c224a9e4aa2dd1c2d0f38c2a784f9e0f290a9fcd42ebfc4658aea950814f1d62fca80",
+		),
+	},
+}
+
+var arkworksG2Vectors = []struct {
+	neg          bool
+	compressed   []byte
+	uncompressed []byte
+}{
+	{
+		false,
+		fromHex(0,
+			"1c5f02cd94c130a85b99bfe14fcf1064b054adc2fb6ee900d708d23ccb4869cebab6e100a3173396c7e770ace9cabbc558eb9ff0f1c34e7368a23dab5d1cb4266d0f89131020064c5f11a7c5aa5310d6f960d6692ea852c816b8d94132131001",
+		),
+		fromHex(0,
+			"1c5f02cd94c130a85b99bfe14fcf1064b054adc2fb6ee900d708d23ccb4869cebab6e100a3173396c7e770ace9cabbc558eb9ff0f1c34e7368a23dab5d1cb4266d0f89131020064c5f11a7c5aa5310d6f960d6692ea852c816b8d94132131001",
+			"613bc72867a170eb89c6eaf99405ec91a5025a3c3a2daa58c7ff4a50cd6fa93e0023a8ff70c10689b812c7d2db93f264ef3f2933b77ec9949ca5950bc8861d0a16e3ff53278ea7811c18c2ce9acfb726dcd2b6e410eb79819f36617735c31700",
+		),
+	},
+	{
+		true,
+		fromHex(0,
+			"1c5f02cd94c130a85b99bfe14fcf1064b054adc2fb6ee900d708d23ccb4869cebab6e100a3173396c7e770ace9cabbc558eb9ff0f1c34e7368a23dab5d1cb4266d0f89131020064c5f11a7c5aa5310d6f960d6692ea852c816b8d94132131081",
+		),
+		fromHex(0,
+			"1c5f02cd94c130a85b99bfe14fcf1064b054adc2fb6ee900d708d23ccb4869cebab6e100a3173396c7e770ace9cabbc558eb9ff0f1c34e7368a23dab5d1cb4266d0f89131020064c5f11a7c5aa5310d6f960d6692ea852c816b8d94132131001",
+			"2ac538d7985e2c09f9391576d338a55492a196ae74cb62bd30a91c06f5a6f7592edc43746b12d6e8d7ea32401135972115e38af2473c056f7c43efd9701f5e662bdb872cc8e7dad06b2e0fb3cf486e92622c44202fde0d506b972284ef240b81",
+		),
+	},
+}
+
+var arkworksGTVector = fromHex(0,
+	"abd2091b7a9fe819e039dd6311fd297d45f4614a557fa8b0825290cd4476c2cc4176e91efc47617d5b99ece9f1b852398b6d6d697d3c38c6795080a2ddd531492ecf73cfc0e970ff1ee87de42041ce066dac80352e020a6fb606540bfe64be00",
+	"df9fa13bff1276bbe077103b50eeeaa475138b13f80ee2689cf3d94001b82e5993a3b29e3157db4c4690c44334a312648d9d5eef6c8204a7276f2b81a9b129fc3f154349373ce3370482d360bc5742b2a4f6a14289a1e73d3462584f6f057100",
+	"33cf1387ade509db01b7b6c4603a5d2ca965d113ed1199023104b864dfbac68480c01c1ba5955fee110138e4e5187cdcabb6814d85f5c054e1e025af185a99ce9b831d9f846c6714fb31c6e35dceecb6b940886bbd7defd912b7d2d2bddea700",
+	"8996693f26581a0f79a2100c224ee0efc512e4b7f7dbd37997b807e4789353bb18362f42ec55cd6bf89e34099325e4fd848d43a0954d3793b3c231b89c05dbce6dc5b67e270c5297c967d7ef3b2be87483d0cd2eb8c285c9da09fd4e40949c00",
+	"903ce87914b565c328af27a4cce288f9407ee35a92ecbb8a02e303c5beb79df327e17a1116eed672c86037e30bc8299d9015fbd485e949f861d6a2a76b55cbcfade82867872ba38b0e5e5c3e3a4e68ab807f86858df226566cac0168c3639200",
+	"71a9ca391fd039e8819f7f59b6e8362912ee980f55e2083f3cd57952cd56c7b3cdc0a5fd6ac59c521a771da793ee61d597650db5a88b98b864db71a5034a840b885634b00278ce278ca2b88d45662c8fc7789584528753df3185a0ff787d4700",
+)
+
+var arkworksFrVectors = []struct {
+	value   string
+	encoded []byte
+}{
+	{"0x1", fromHex(0, "010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000")},
+	{"0x1ae3a4617c510eac63b05c06ca1493b1a22d9f300f5138f1ef3622fba094800170b5d44300000008508c00000000000", fromHex(0, "0000000000c0088500000030445d0b17004809ba2f62f31e8f13f500f3d9221a3b49a16cc0053bc6ea10c517463aae01")},
+	{"0x123456789abcdeffedcba987654321000112233445566778899aabbccddeeff0123456789abcdef0000000000000001", fromHex(0, "0100000000000000efcdab8967452301ffeeddccbbaa998877665544332211001032547698badcfeefcdab8967452301")},
+}
+
+func TestArkworksG1Vectors(t *testing.T) {
+	g := NewG1()
+	for i, v := range arkworksG1Vectors {
+		expected := g.One()
+		if v.neg {
+			g.Neg(expected, expected)
+		}
+		p0, err := g.FromArkworks(v.compressed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		p1, err := g.FromArkworks(v.uncompressed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !g.Equal(p0, expected) || !g.Equal(p1, expected) {
+			t.Fatal("arkworks decoding failed", i)
+		}
+		if !bytes.Equal(g.ToArkworksCompressed(expected), v.compressed) {
+			t.Fatal("arkworks compressed encoding failed", i)
+		}
+		if !bytes.Equal(g.ToArkworks(expected), v.uncompressed) {
+			t.Fatal("arkworks uncompressed encoding failed", i)
+		}
+	}
+}
+
+func TestArkworksG2Vectors(t *testing.T) {
+	g := NewG2()
+	for i, v := range arkworksG2Vectors {
+		expected := g.One()
+		if v.neg {
+			g.Neg(expected, expected)
+		}
+		p0, err := g.FromArkworks(v.compressed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		p1, err := g.FromArkworks(v.uncompressed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !g.Equal(p0, expected) || !g.Equal(p1, expected) {
+			t.Fatal("arkworks decoding failed", i)
+		}
+		if !bytes.Equal(g.ToArkworksCompressed(expected), v.compressed) {
+			t.Fatal("arkworks compressed encoding failed", i)
+		}
+		if !bytes.Equal(g.ToArkworks(expected), v.uncompressed) {
+			t.Fatal("arkworks uncompressed encoding failed", i)
+		}
+	}
+}
+
+func TestArkworksInfinity(t *testing.T) {
+	g1, g2 := NewG1(), NewG2()
+	compressed := make([]byte, fpByteSize)
+	compressed[fpByteSize-1] = arkworksInfinity
+	uncompressed := make([]byte, 2*fpByteSize)
+	uncompressed[2*fpByteSize-1] = arkworksInfinity
+	if !bytes.Equal(g1.ToArkworksCompressed(g1.Zero()), compressed) || !bytes.Equal(g1.ToArkworks(g1.Zero()), uncompressed) {
+		t.Fatal("arkworks infinity encoding failed")
+	}
+	if !bytes.Equal(g2.ToArkworksCompressed(g2.Zero()), compressed) || !bytes.Equal(g2.ToArkworks(g2.Zero()), uncompressed) {
+		t.Fatal("arkworks infinity encoding failed")
+	}
+	for _, in := range [][]byte{compressed, uncompressed} {
+		p1, err := g1.FromArkworks(in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		p2, err := g2.FromArkworks(in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !g1.IsZero(p1) || !g2.IsZero(p2) {
+			t.Fatal("arkworks infinity decoding failed")
+		}
+	}
+}
+
+func TestArkworksSerialization(t *testing.T) {
+	g := NewG1()
+	for i := 0; i < fuz; i++ {
+		a := g.randCorrect()
+		p0, err := g.FromArkworks(g.ToArkworksCompressed(a))
+		if err != nil {
+			t.Fatal(err)
+		}
+		p1, err := g.FromArkworks(g.ToArkworks(a))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !g.Equal(a, p0) || !g.Equal(a, p1) {
+			t.Fatal("arkworks serialization failed")
+		}
+		// points out of correct subgroup are rejected unless the check is skipped
+		b := g.rand()
+		for _, in := range [][]byte{g.ToArkworksCompressed(b), g.ToArkworks(b)} {
+			if _, err := g.FromArkworks(in); err == nil {
+				t.Fatal("point out of correct subgroup must be rejected")
+			}
+			p, err := g.FromArkworksUnchecked(in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !g.Equal(b, p) {
+				t.Fatal("arkworks serialization failed")
+			}
+		}
+	}
+	g2 := NewG2()
+	b := g2.rand()
+	if _, err := g2.FromArkworks(g2.ToArkworks(b)); err == nil {
+		t.Fatal("point out of correct subgroup must be rejected")
+	}
+	if p, err := g2.FromArkworksUnchecked(g2.ToArkworks(b)); err != nil || !g2.Equal(b, p) {
+		t.Fatal("arkworks serialization failed", err)
+	}
+	v := arkworksG1Vectors[0]
+	modulusBytes := reverseBytes(padBytes(modulus.big().Bytes(), fpByteSize))
+	bothFlags := append([]byte{}, v.compressed...)
+	bothFlags[fpByteSize-1] |= arkworksFlagMask
+	notReduced := append(append([]byte{}, modulusBytes...), v.uncompressed[fpByteSize:]...)
+	notOnCurve := append([]byte{}, v.uncompressed...)
+	notOnCurve[0] ^= 1
+	nonZeroInfinity := append([]byte{}, v.compressed...)
+	nonZeroInfinity[fpByteSize-1] |= arkworksInfinity
+	for _, in := range [][]byte{{}, v.compressed[1:], bothFlags, notReduced, notOnCurve, nonZeroInfinity} {
+		if _, err := g.FromArkworks(in); err == nil {
+			t.Fatal("invalid arkworks encoding must be rejected")
+		}
+	}
+}
+
+func TestArkworksFrVectors(t *testing.T) {
+	for i, v := range arkworksFrVectors {
+		expected := FrFromBig(bigFromHex(v.value))
+		e, err := FrFromArkworks(v.encoded)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !e.Equal(expected) {
+			t.Fatal("arkworks scalar decoding failed", i)
+		}
+		if !bytes.Equal(expected.ToArkworks(), v.encoded) {
+			t.Fatal("arkworks scalar encoding failed", i)
+		}
+	}
+	for i := 0; i < fuz; i++ {
+		e0, _ := new(Fr).Rand(rand.Reader)
+		e1, err := FrFromArkworks(e0.ToArkworks())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !e0.Equal(e1) {
+			t.Fatal("arkworks scalar serialization failed")
+		}
+	}
+	if _, err := FrFromArkworks(reverseBytes(padBytes(q.Bytes(), frByteSize))); err == nil {
+		t.Fatal("non canonical scalar must be rejected")
+	}
+}
+
+func TestArkworksGTVector(t *testing.T) {
+	bw6 := NewEngine()
+	GT := bw6.GT()
+	expected := bw6.AddPair(bw6.g1.One(), bw6.g2.One()).Result()
+	e, err := GT.FromArkworks(arkworksGTVector)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !e.Equal(expected) {
+		t.Fatal("arkworks target group element decoding failed")
+	}
+	if !bytes.Equal(GT.ToArkworks(expected), arkworksGTVector) {
+		t.Fatal("arkworks target group element encoding failed")
+	}
+}
@@ -4,6 +4,8 @@ const fpNumberOfLimbs = 12
 const fpByteSize = 96
 const fpBitSize = 761
 const twelveWordBitSize = 768
+const frNumberOfLimbs = 6
+const frByteSize = 48
 const frBitSize = 377
 const sixWordBitSize = 384
 
@@ -44,12 +46,21 @@ var pPlus1Over4 = bigFromHex("0x48ba093ee0f382b461f250013ebfcfae49861aa07451a214
 // pMinus1Over2 = (p - 1) / 2
 var pMinus1Over2 = bigFromHex("0x9174127dc1e70568c3e4a0027d7f9f5c930c3540e8a34429413af7c043df20b83dd31c72c2748c81e75d7f92da11824344e476897cfec838ee69ee39f5ff974c508b612b33d47c0b067c577578521bf3489f34380000417a4e800000000045")
 
+// pMinus2 = p - 2
+var pMinus2 = bigFromHex("0x122e824fb83ce0ad187c94004faff3eb926186a81d14688528275ef8087be41707ba638e584e91903cebaff25b423048689c8ed12f9fd9071dcd3dc73ebff2e98a116c25667a8f8160cf8aeeaf0a437e6913e6870000082f49d000000000089")
+
+// pMinus2Windows is p - 2 in fixed 4-bit windows, least significant first
+var pMinus2Windows = bigToWindows(pMinus2, 4)
+
 // parameter of p where p is actuall parameterized polynomial p(x)
 var x = bigFromHex("0x8508c00000000001")
 var ateLoop1 = bigFromHex("0x8508c00000000002")
 var ateLoop2 = bigFromHex("0x23ed1347970dec008a442f991fffffffffffffffffffffff")
 var ateLoop2NAF = bigToWNAF(ateLoop2, 1)
 
+// (x - 1) / 3 which is used in G2 subgroup check
+var xMinusOneOver3 = bigFromHex("0x2c58400000000000")
+
 /*
 	Curve
 	y^2 = x+3 + b
@@ -59,6 +70,40 @@ var ateLoop2NAF = bigToWNAF(ateLoop2, 1)
 // q = x^6 - 2x^5 + 2x^3 + x + 1
 var q = bigFromHex("0x1ae3a4617c510eac63b05c06ca1493b1a22d9f300f5138f1ef3622fba094800170b5d44300000008508c00000000001")
 
+// Scalar field
+// r = 2 ^ 384
+
+// -q^(-1) mod 2^64
+var frInp uint64 = 0x8508bfffffffffff
+
+// supress linter warning: this variable used in assembly code
+var _ = frInp
+
+// frModulus = q
+var frModulus = Fr{0x8508c00000000001, 0x170b5d4430000000, 0x1ef3622fba094800, 0x1a22d9f300f5138f, 0xc63b05c06ca1493b, 0x01ae3a4617c510ea}
+
+// frR1 = r mod q
+var frR1 = &Fr{0x02cdffffffffff68, 0x51409f837fffffb1, 0x9f7db3a98a7d3ff2, 0x7b4e97b76e7c6305, 0x4cf495bf803c84e8, 0x008d6661e2fdf49a}
+
+// frR2 = r^2 mod q
+var frR2 = &Fr{0xb786686c9400cd22, 0x0329fcaab00431b1, 0x22a5f11162d6b46d, 0xbfdf7d03827dc3ac, 0x837e92f041790bf9, 0x006dfccb1e914b88}
+
+// qMinus2 = q - 2
+var qMinus2 = bigFromHex("0x1ae3a4617c510eac63b05c06ca1493b1a22d9f300f5138f1ef3622fba094800170b5d44300000008508bfffffffffff")
+
+// qMinus1Over2 = (q - 1) / 2
+var qMinus1Over2 = bigFromHex("0xd71d230be28875631d82e03650a49d8d116cf9807a89c78f79b117dd04a4000b85aea2180000004284600000000000")
+
+// q - 1 = 2^s * t where t is odd
+// frTwoAdicity = s
+const frTwoAdicity = 46
+
+// qOddFactorMinus1Over2 = (t - 1) / 2
+var qOddFactorMinus1Over2 = bigFromHex("0x35c748c2f8a21d58c760b80d94292763445b3e601ea271e3de6c45f741290002e16ba88600000010a11")
+
+// frRootOfUnity = 15^t is a primitive 2^s-th root of unity
+var frRootOfUnity = &Fr{0xdfcae622791aab1e, 0x720bc7a4bf05c59c, 0x259d41860d7882d6, 0xd82b4258b1e4da96, 0xb7f9a1cc67b4e064, 0x00fda47f566e4289}
+
 // b coefficient for G1
 // b = -1
 var b = new(fe).set(negativeOne)
@@ -74,14 +119,14 @@ var cofactorG1 = bigFromHex("0xad1972339049ce762c77d5ac34cb12efc856a0853c9db94cc
 var cofactorG2 = bigFromHex("0xad1972339049ce762c77d5ac34cb12efc856a0853c9db94cc61c554757551c0c832ba4061000003b3de5800000000075")
 
 // G1 generator
-var g1One = Point{
+var g1One = PointG1{
 	fe{0xd6e42d7614c2d770, 0x4bb886eddbc3fc21, 0x64648b044098b4d2, 0x1a585c895a422985, 0xf1a9ac17cf8685c9, 0x352785830727aea5, 0xddf8cb12306266fe, 0x6913b4bfbc9e949a, 0x3a4b78d67ba5f6ab, 0x0f481c06a8d02a04, 0x91d4e7365c43edac, 0x00f4d17cd48beca5},
 	fe{0x97e805c4bd16411f, 0x870d844e1ee6dd08, 0x1eba7a37cb9eab4d, 0xd544c4df10b9889a, 0x8fe37f21a33897be, 0xe9bf99a43a0885d2, 0xd7ee0c9e273de139, 0xaa6a9ec7a38dd791, 0x8f95d3fcf765da8e, 0x42326e7db7357c99, 0xe217e407e218695f, 0x009d1eb23b7cf684},
 	*new(fe).set(one),
 }
 
 // G2 Generator
-var g2One = Point{
+var g2One = PointG2{
 	fe{0x3d902a84cd9f4f78, 0x864e451b8a9c05dd, 0xc2b3c0d6646c5673, 0x17a7682def1ecb9d, 0xbe31a1e0fb768fe3, 0x4df125e09b92d1a6, 0x0943fce635b02ee9, 0xffc8e7ad0605e780, 0x8165c00a39341e95, 0x8ccc2ae90a0f094f, 0x73a8b8cc0ad09e0c, 0x011027e203edd9f4},
 	fe{0x9a159be4e773f67c, 0x6b957244aa8f4e6b, 0xa27b70c9c945a38c, 0xacb6a09fda11d0ab, 0x3abbdaa9bb6b1291, 0xdbdf642af5694c36, 0xb6360bb9560b369f, 0xac0bd1e822b8d6da, 0xfa355d17afe6945f, 0x8d6a0fc1fbcad35e, 0x72a63c7874409840, 0x0114976e5b0db280},
 	*new(fe).set(one),
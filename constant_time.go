@@ -0,0 +1,211 @@
+package bw6
+
+import (
+	"math/bits"
+)
+
+// Constant time scalar multiplication is intended for secret scalars.
+// Scalars are recoded into regular signed digits, table lookups scan all entries
+// and points are added with complete formulas in homogeneous projective coordinates
+// so that the sequence of operations and memory accesses does not depend on the scalar.
+// Note that field arithmetic of the generic fallback branches on carries and only
+// assembly implementations are expected to run in constant time.
+
+// ctMulWindow is the bit size of signed digits of the regular recoding.
+const ctMulWindow = 4
+
+// ctMulDigits is the number of digits required to represent a scalar that is less than 2q.
+const ctMulDigits = (frBitSize + 1 + ctMulWindow - 1) / ctMulWindow
+
+// cmov sets p to q if bit is one and leaves p unchanged if bit is zero.
+func (p *point) cmov(q *point, bit uint64) *point {
+	mask := -bit
+	for i := 0; i < 3; i++ {
+		for j := 0; j < fpNumberOfLimbs; j++ {
+			p[i][j] ^= (p[i][j] ^ q[i][j]) & mask
+		}
+	}
+	return p
+}
+
+// regularRecoding returns odd signed digits d_i in [-(2^w - 1), 2^w - 1] where
+// k + (1 - k mod 2) * q = sum(d_i * 2^(w * i)) for a canonical scalar k.
+// Even scalars are made odd by adding q which does not change the result in the subgroup.
+func regularRecoding(e *Fr) [ctMulDigits]int64 {
+	k := new(Fr)
+	fromMontFR(k, e)
+	var carry uint64
+	mask := (k[0] & 1) - 1
+	for i := 0; i < frNumberOfLimbs; i++ {
+		k[i], carry = bits.Add64(k[i], frModulus[i]&mask, carry)
+	}
+	var digits [ctMulDigits]int64
+	for i := 0; i < ctMulDigits-1; i++ {
+		digits[i] = int64(k[0]&(1<<(ctMulWindow+1)-1)) - 1<<ctMulWindow
+		// k = (k - d) / 2^w = (k >> w) | 1
+		for j := 0; j < frNumberOfLimbs-1; j++ {
+			k[j] = k[j]>>ctMulWindow | k[j+1]<<(64-ctMulWindow)
+		}
+		k[frNumberOfLimbs-1] >>= ctMulWindow
+		k[0] |= 1
+	}
+	digits[ctMulDigits-1] = int64(k[0])
+	return digits
+}
+
+// toProjective converts a point in jacobian coordinates to homogeneous projective coordinates
+// (x, y, z) -> (x * z, y, z^3) and the point at infinity to (0, 1, 0).
+func toProjective(r, p *point) *point {
+	t := new(fe)
+	z := &p[2]
+	isZero := ctEq(z[0]|z[1]|z[2]|z[3]|z[4]|z[5]|z[6]|z[7]|z[8]|z[9]|z[10]|z[11], 0)
+	mul(&r[0], &p[0], &p[2])
+	square(t, &p[2])
+	mul(&r[2], t, &p[2])
+	r[1].set(&p[1])
+	return r.cmov(new(point).zero(), isZero)
+}
+
+// fromProjectiveAffine converts a point in homogeneous projective coordinates to affine form
+// (x, y, z) -> (x / z, y / z, 1) with constant time inversion and the point at infinity to (0, 1, 0).
+func fromProjectiveAffine(r, p *point) *point {
+	zInv := new(fe)
+	z := &p[2]
+	isZero := ctEq(z[0]|z[1]|z[2]|z[3]|z[4]|z[5]|z[6]|z[7]|z[8]|z[9]|z[10]|z[11], 0)
+	inverseCT(zInv, z)
+	mul(&r[0], &p[0], zInv)
+	mul(&r[1], &p[1], zInv)
+	r[2].one()
+	return r.cmov(new(point).zero(), isZero)
+}
+
+// addComplete adds points in homogeneous projective coordinates with no exceptional cases
+// for points in odd order subgroups. b3 is expected to be 3b.
+// Algorithm 7 of https://eprint.iacr.org/2015/1060
+func addComplete(r, p1, p2 *point, b3 *fe) *point {
+	t0, t1, t2, t3, t4 := new(fe), new(fe), new(fe), new(fe), new(fe)
+	x3, y3, z3 := new(fe), new(fe), new(fe)
+	mul(t0, &p1[0], &p2[0])
+	mul(t1, &p1[1], &p2[1])
+	mul(t2, &p1[2], &p2[2])
+	add(t3, &p1[0], &p1[1])
+	add(t4, &p2[0], &p2[1])
+	mul(t3, t3, t4)
+	add(t4, t0, t1)
+	sub(t3, t3, t4)
+	add(t4, &p1[1], &p1[2])
+	add(x3, &p2[1], &p2[2])
+	mul(t4, t4, x3)
+	add(x3, t1, t2)
+	sub(t4, t4, x3)
+	add(x3, &p1[0], &p1[2])
+	add(y3, &p2[0], &p2[2])
+	mul(x3, x3, y3)
+	add(y3, t0, t2)
+	sub(y3, x3, y3)
+	add(x3, t0, t0)
+	add(t0, x3, t0)
+	mul(t2, b3, t2)
+	add(z3, t1, t2)
+	sub(t1, t1, t2)
+	mul(y3, b3, y3)
+	mul(x3, t4, y3)
+	mul(t2, t3, t1)
+	sub(x3, t2, x3)
+	mul(y3, y3, t0)
+	mul(t1, t1, z3)
+	add(y3, t1, y3)
+	mul(t0, t0, t3)
+	mul(z3, z3, t4)
+	add(z3, z3, t0)
+	r[0].set(x3)
+	r[1].set(y3)
+	r[2].set(z3)
+	return r
+}
+
+// doubleComplete doubles a point in homogeneous projective coordinates. b3 is expected to be 3b.
+// Algorithm 9 of https://eprint.iacr.org/2015/1060
+func doubleComplete(r, p *point, b3 *fe) *point {
+	t0, t1, t2 := new(fe), new(fe), new(fe)
+	x3, y3, z3 := new(fe), new(fe), new(fe)
+	square(t0, &p[1])
+	add(z3, t0, t0)
+	add(z3, z3, z3)
+	add(z3, z3, z3)
+	mul(t1, &p[1], &p[2])
+	square(t2, &p[2])
+	mul(t2, b3, t2)
+	mul(x3, t2, z3)
+	add(y3, t0, t2)
+	mul(z3, t1, z3)
+	add(t1, t2, t2)
+	add(t2, t1, t2)
+	sub(t0, t0, t2)
+	mul(y3, t0, y3)
+	add(y3, x3, y3)
+	mul(t1, &p[0], &p[1])
+	mul(x3, t0, t1)
+	add(x3, x3, x3)
+	r[0].set(x3)
+	r[1].set(y3)
+	r[2].set(z3)
+	return r
+}
+
+// mulScalarCT multiplies a point in correct subgroup by a secret scalar in constant time.
+// Result is in affine form which is normalized with constant time inversion so that
+// callers do not need a variable time inversion on the secret dependent point.
+func (g *group) mulScalarCT(r, p *point, e *Fr) *point {
+	b3 := new(fe)
+	double(b3, g.b)
+	add(b3, b3, g.b)
+	// table of odd multiples P, 3P, 5P, ..., (2^w - 1)P
+	var table [1 << (ctMulWindow - 1)]point
+	toProjective(&table[0], p)
+	p2 := doubleComplete(new(point), &table[0], b3)
+	for i := 1; i < len(table); i++ {
+		addComplete(&table[i], &table[i-1], p2, b3)
+	}
+	digits := regularRecoding(e)
+	acc, t, negT := new(point), new(point), new(point)
+	lookup := func(d int64) {
+		s := d >> 63
+		idx := uint64(((d ^ s) - s - 1) >> 1)
+		t.zero()
+		for i := 0; i < len(table); i++ {
+			t.cmov(&table[i], ctEq(idx, uint64(i)))
+		}
+		negT.set(t)
+		negCT(&negT[1], &t[1])
+		t.cmov(negT, uint64(s)&1)
+	}
+	lookup(digits[ctMulDigits-1])
+	acc.set(t)
+	for i := ctMulDigits - 2; i >= 0; i-- {
+		for j := 0; j < ctMulWindow; j++ {
+			doubleComplete(acc, acc, b3)
+		}
+		lookup(digits[i])
+		addComplete(acc, acc, t, b3)
+	}
+	return fromProjectiveAffine(r, acc)
+}
+
+// ctEq returns one if a equals b and zero otherwise without branching.
+func ctEq(a, b uint64) uint64 {
+	x := a ^ b
+	return 1 ^ ((x | -x) >> 63)
+}
+
+// negCT sets z to -x without branching on x. Zero is mapped to zero
+// by masking p - x with the zero test instead of a conditional.
+func negCT(z, x *fe) {
+	var borrow uint64
+	isZero := ctEq(x[0]|x[1]|x[2]|x[3]|x[4]|x[5]|x[6]|x[7]|x[8]|x[9]|x[10]|x[11], 0)
+	mask := isZero - 1
+	for i := 0; i < fpNumberOfLimbs; i++ {
+		z[i], borrow = bits.Sub64(modulus[i], x[i], borrow)
+		z[i] &= mask
+	}
+}
@@ -0,0 +1,49 @@
+package bw6
+
+import (
+	"crypto/rand"
+	"math/big"
+	"testing"
+)
+
+func TestNegCT(t *testing.T) {
+	in := []*fe{new(fe).zero(), new(fe).one(), new(fe).set(&fe{1})}
+	for i := 0; i < fuz; i++ {
+		e, _ := new(fe).rand(rand.Reader)
+		in = append(in, e)
+	}
+	for _, e := range in {
+		r0, r1 := new(fe), new(fe)
+		negCT(r0, e)
+		neg(r1, e)
+		if !r0.equal(r1) {
+			t.Fatal("constant time negation does not agree with neg", e)
+		}
+	}
+}
+
+func TestRegularRecoding(t *testing.T) {
+	qMinus1 := new(big.Int).Sub(q, big.NewInt(1))
+	scalars := []*big.Int{big.NewInt(0), big.NewInt(1), big.NewInt(2), qMinus1}
+	for i := 0; i < fuz; i++ {
+		scalars = append(scalars, randScalar(q))
+	}
+	for _, s := range scalars {
+		digits := regularRecoding(FrFromBig(s))
+		expected := new(big.Int).Set(s)
+		if s.Bit(0) == 0 {
+			expected.Add(expected, q)
+		}
+		r := new(big.Int)
+		for i := ctMulDigits - 1; i >= 0; i-- {
+			d := digits[i]
+			if d%2 == 0 || d >= 1<<ctMulWindow || d <= -(1<<ctMulWindow) {
+				t.Fatal("bad digit", d)
+			}
+			r.Lsh(r, ctMulWindow).Add(r, big.NewInt(d))
+		}
+		if r.Cmp(expected) != 0 {
+			t.Fatal("bad recoding", s)
+		}
+	}
+}
@@ -0,0 +1,211 @@
+package bw6
+
+import (
+	"errors"
+	"math/big"
+	"math/bits"
+	"runtime"
+	"sync"
+)
+
+// frGenerator is a multiplicative generator of the scalar field.
+// 15 is a quadratic non residue so that it generates the whole 2-adic subgroup.
+var frGenerator = uint64(15)
+
+// fftParallelThreshold is the smallest domain size that FFT runs in parallel.
+var fftParallelThreshold = 1 << 12
+
+// Domain is a multiplicative subgroup of the scalar field with size of power of two.
+// Domain is used for polynomial evaluation and interpolation with radix-2 FFT.
+type Domain struct {
+	n           int
+	logN        uint
+	omega       Fr
+	omegaInv    Fr
+	nInv        Fr
+	coset       Fr
+	cosetInv    Fr
+	twiddles    []Fr
+	twiddlesInv []Fr
+}
+
+// NewDomain constructs an evaluation domain which is large enough to cover n points.
+// Size of the domain is n rounded up to the next power of two.
+func NewDomain(n int) (*Domain, error) {
+	if n < 1 {
+		return nil, errors.New("domain size must be positive")
+	}
+	logN := uint(bits.Len(uint(n - 1)))
+	if logN > frTwoAdicity {
+		return nil, errors.New("domain size exceeds two-adicity of the scalar field")
+	}
+	if logN >= bits.UintSize-1 {
+		return nil, errors.New("domain size exceeds maximum int")
+	}
+	d := &Domain{n: 1 << logN, logN: logN}
+
+	// omega = g ^ ((q - 1) / n) = (g ^ t) ^ (2 ^ (s - logN))
+	d.omega.Set(frRootOfUnity)
+	for i := logN; i < frTwoAdicity; i++ {
+		squareFR(&d.omega, &d.omega)
+	}
+	d.coset.SetUint64(frGenerator)
+	d.cosetInv.Inverse(&d.coset)
+	d.omegaInv.Inverse(&d.omega)
+	d.nInv.SetUint64(uint64(d.n)).Inverse(&d.nInv)
+
+	d.twiddles = make([]Fr, d.n/2+1)
+	d.twiddlesInv = make([]Fr, d.n/2+1)
+	d.twiddles[0].One()
+	d.twiddlesInv[0].One()
+	for i := 1; i < len(d.twiddles); i++ {
+		d.twiddles[i].Mul(&d.twiddles[i-1], &d.omega)
+		d.twiddlesInv[i].Mul(&d.twiddlesInv[i-1], &d.omegaInv)
+	}
+	return d, nil
+}
+
+// Size returns number of elements in the domain.
+func (d *Domain) Size() int {
+	return d.n
+}
+
+// Generator returns a new element which is the generator of the domain.
+func (d *Domain) Generator() *Fr {
+	return new(Fr).Set(&d.omega)
+}
+
+// Element returns a new element which is the i-th power of the generator.
+// Negative indices are reduced modulo the size of the domain.
+func (d *Domain) Element(i int) *Fr {
+	i %= d.n
+	if i < 0 {
+		i += d.n
+	}
+	return new(Fr).Exp(&d.omega, big.NewInt(int64(i)))
+}
+
+// FFT evaluates a polynomial given in coefficient form over the domain.
+// Input is expected to be equal to the size of domain and is replaced with evaluations in natural order.
+func (d *Domain) FFT(a []*Fr) error {
+	if len(a) != d.n {
+		return errors.New("input size must be equal to domain size")
+	}
+	d.fft(a, d.twiddles)
+	return nil
+}
+
+// InverseFFT interpolates evaluations over the domain given in natural order.
+// Input is expected to be equal to the size of domain and is replaced with coefficients.
+func (d *Domain) InverseFFT(a []*Fr) error {
+	if len(a) != d.n {
+		return errors.New("input size must be equal to domain size")
+	}
+	d.fft(a, d.twiddlesInv)
+	d.scale(a, &d.nInv)
+	return nil
+}
+
+// CosetFFT evaluates a polynomial given in coefficient form over the coset g * D
+// where g is the multiplicative generator of the scalar field.
+func (d *Domain) CosetFFT(a []*Fr) error {
+	if len(a) != d.n {
+		return errors.New("input size must be equal to domain size")
+	}
+	d.distribute(a, &d.coset)
+	d.fft(a, d.twiddles)
+	return nil
+}
+
+// CosetInverseFFT interpolates evaluations over the coset g * D given in natural order.
+func (d *Domain) CosetInverseFFT(a []*Fr) error {
+	if len(a) != d.n {
+		return errors.New("input size must be equal to domain size")
+	}
+	d.fft(a, d.twiddlesInv)
+	d.scale(a, &d.nInv)
+	d.distribute(a, &d.cosetInv)
+	return nil
+}
+
+// BitReverse permutes elements in place such that element at index i is swapped
+// with the element at the bit reversed index. Length of input is expected to be power of two.
+func BitReverse(a []*Fr) {
+	n := len(a)
+	if n < 2 {
+		return
+	}
+	shift := uint(64 - bits.Len(uint(n-1)))
+	for i := 0; i < n; i++ {
+		j := int(bits.Reverse64(uint64(i)) >> shift)
+		if i < j {
+			a[i], a[j] = a[j], a[i]
+		}
+	}
+}
+
+func (d *Domain) fft(a []*Fr, twiddles []Fr) {
+	BitReverse(a)
+	workers := workerCount(d.n)
+	// radix-2 decimation in time
+	for m := 1; m < d.n; m <<= 1 {
+		stride := d.n / (2 * m)
+		butterfly := func(start, end int) {
+			t := new(Fr)
+			for b := start; b < end; b++ {
+				j := b % m
+				k := (b/m)*2*m + j
+				mulFR(t, a[k+m], &twiddles[j*stride])
+				subFR(a[k+m], a[k], t)
+				addFR(a[k], a[k], t)
+			}
+		}
+		parallel(d.n/2, workers, butterfly)
+	}
+}
+
+// scale multiplies all elements with the given constant
+func (d *Domain) scale(a []*Fr, c *Fr) {
+	for i := 0; i < len(a); i++ {
+		mulFR(a[i], a[i], c)
+	}
+}
+
+// distribute multiplies i-th element with c^i
+func (d *Domain) distribute(a []*Fr, c *Fr) {
+	acc := new(Fr).Set(c)
+	for i := 1; i < len(a); i++ {
+		mulFR(a[i], a[i], acc)
+		mulFR(acc, acc, c)
+	}
+}
+
+// workerCount returns number of concurrent workers for a job with given size
+func workerCount(n int) int {
+	if n < fftParallelThreshold {
+		return 1
+	}
+	return runtime.NumCPU()
+}
+
+// parallel splits the range [0, n) into chunks and runs the job over chunks concurrently.
+func parallel(n, workers int, job func(start, end int)) {
+	if workers < 2 || n < workers {
+		job(0, n)
+		return
+	}
+	chunk := (n + workers - 1) / workers
+	var wg sync.WaitGroup
+	for start := 0; start < n; start += chunk {
+		end := start + chunk
+		if end > n {
+			end = n
+		}
+		wg.Add(1)
+		go func(start, end int) {
+			defer wg.Done()
+			job(start, end)
+		}(start, end)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,196 @@
+package bw6
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"math/bits"
+	"testing"
+)
+
+func randFrVector(n int) []*Fr {
+	a := make([]*Fr, n)
+	for i := 0; i < n; i++ {
+		a[i], _ = new(Fr).Rand(rand.Reader)
+	}
+	return a
+}
+
+func copyFrVector(a []*Fr) []*Fr {
+	b := make([]*Fr, len(a))
+	for i := 0; i < len(a); i++ {
+		b[i] = new(Fr).Set(a[i])
+	}
+	return b
+}
+
+// naiveEvaluation evaluates polynomial at points shift * omega^i
+func naiveEvaluation(coeffs []*Fr, omega, shift *Fr) []*Fr {
+	n := len(coeffs)
+	out := make([]*Fr, n)
+	x := new(Fr).Set(shift)
+	for i := 0; i < n; i++ {
+		acc := new(Fr)
+		for j := n - 1; j >= 0; j-- {
+			acc.Mul(acc, x).Add(acc, coeffs[j])
+		}
+		out[i] = acc
+		x.Mul(x, omega)
+	}
+	return out
+}
+
+func TestDomainConstruction(t *testing.T) {
+	for _, n := range []int{1, 2, 3, 5, 8, 100, 1 << 10} {
+		d, err := NewDomain(n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if d.Size() < n || d.Size()&(d.Size()-1) != 0 || d.Size() >= 2*n && n > 1 {
+			t.Fatal("bad domain size", n, d.Size())
+		}
+		w := d.Generator()
+		u := new(Fr).Exp(w, big.NewInt(int64(d.Size())))
+		if !u.IsOne() {
+			t.Fatal("omega^n == 1")
+		}
+		if d.Size() > 1 {
+			u.Exp(w, big.NewInt(int64(d.Size()/2)))
+			if u.IsOne() {
+				t.Fatal("omega must be primitive")
+			}
+		}
+		if !d.Element(d.Size() + 1).Equal(w) {
+			t.Fatal("omega^(n+1) == omega")
+		}
+		if !d.Element(-1).Mul(d.Element(-1), w).IsOne() || !d.Element(-d.Size()).IsOne() {
+			t.Fatal("omega^-1 * omega == 1")
+		}
+	}
+	if _, err := NewDomain(0); err == nil {
+		t.Fatal("zero sized domain must be rejected")
+	}
+	if bits.UintSize == 64 {
+		n := uint64(1)<<46 + 1
+		if _, err := NewDomain(int(n)); err == nil {
+			t.Fatal("domain larger than two-adicity must be rejected")
+		}
+	} else {
+		if _, err := NewDomain(1<<30 + 1); err == nil {
+			t.Fatal("domain larger than maximum int must be rejected")
+		}
+	}
+}
+
+func TestBitReverse(t *testing.T) {
+	n := 16
+	a := make([]*Fr, n)
+	for i := 0; i < n; i++ {
+		a[i] = new(Fr).SetUint64(uint64(i))
+	}
+	BitReverse(a)
+	expected := []uint64{0, 8, 4, 12, 2, 10, 6, 14, 1, 9, 5, 13, 3, 11, 7, 15}
+	for i := 0; i < n; i++ {
+		if !a[i].Equal(new(Fr).SetUint64(expected[i])) {
+			t.Fatal("bit reversal failed")
+		}
+	}
+	BitReverse(a)
+	for i := 0; i < n; i++ {
+		if !a[i].Equal(new(Fr).SetUint64(uint64(i))) {
+			t.Fatal("bit reversal must be an involution")
+		}
+	}
+}
+
+func TestFFTCrossAgainstNaive(t *testing.T) {
+	for n := 1; n <= 64; n <<= 1 {
+		d, err := NewDomain(n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		coeffs := randFrVector(n)
+		one := new(Fr).One()
+		expected := naiveEvaluation(coeffs, &d.omega, one)
+		a := copyFrVector(coeffs)
+		if err := d.FFT(a); err != nil {
+			t.Fatal(err)
+		}
+		for i := 0; i < n; i++ {
+			if !a[i].Equal(expected[i]) {
+				t.Fatal("fft failed", n, i)
+			}
+		}
+		if err := d.InverseFFT(a); err != nil {
+			t.Fatal(err)
+		}
+		for i := 0; i < n; i++ {
+			if !a[i].Equal(coeffs[i]) {
+				t.Fatal("inverse fft failed", n, i)
+			}
+		}
+		shift := new(Fr).SetUint64(frGenerator)
+		expected = naiveEvaluation(coeffs, &d.omega, shift)
+		if err := d.CosetFFT(a); err != nil {
+			t.Fatal(err)
+		}
+		for i := 0; i < n; i++ {
+			if !a[i].Equal(expected[i]) {
+				t.Fatal("coset fft failed", n, i)
+			}
+		}
+		if err := d.CosetInverseFFT(a); err != nil {
+			t.Fatal(err)
+		}
+		for i := 0; i < n; i++ {
+			if !a[i].Equal(coeffs[i]) {
+				t.Fatal("coset inverse fft failed", n, i)
+			}
+		}
+	}
+}
+
+func TestFFTParallel(t *testing.T) {
+	n := 1 << 10
+	d, err := NewDomain(n)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := randFrVector(n)
+	b := copyFrVector(a)
+	threshold := fftParallelThreshold
+	defer func() { fftParallelThreshold = threshold }()
+	fftParallelThreshold = n + 1
+	_ = d.FFT(a)
+	fftParallelThreshold = 1
+	_ = d.FFT(b)
+	for i := 0; i < n; i++ {
+		if !a[i].Equal(b[i]) {
+			t.Fatal("parallel fft failed")
+		}
+	}
+	_ = d.InverseFFT(b)
+	_ = d.FFT(b)
+	for i := 0; i < n; i++ {
+		if !a[i].Equal(b[i]) {
+			t.Fatal("parallel inverse fft failed")
+		}
+	}
+	if err := d.FFT(a[1:]); err == nil {
+		t.Fatal("input size mismatch must be rejected")
+	}
+}
+
+func BenchmarkFFT(t *testing.B) {
+	for _, logN := range []int{10, 14, 16} {
+		n := 1 << uint(logN)
+		d, _ := NewDomain(n)
+		a := randFrVector(n)
+		t.Run(fmt.Sprintf("2^%d", logN), func(t *testing.B) {
+			t.ResetTimer()
+			for i := 0; i < t.N; i++ {
+				_ = d.FFT(a)
+			}
+		})
+	}
+}
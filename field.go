@@ -0,0 +1,376 @@
+package bw6
+
+import (
+	"errors"
+	"io"
+	"math/big"
+)
+
+// Fe is type for base field element. Elements are kept in Montgomery form.
+type Fe = fe
+
+// Fe3 is type for cubic extension field element where u^3 = -4.
+type Fe3 = fe3
+
+// Fe6 is type for sextic extension field element where v^2 = u.
+type Fe6 = fe6
+
+// FeFromBytes constructs a base field element from 96 bytes big-endian input.
+// Input is expected to be in canonical form that is less than the modulus.
+func FeFromBytes(in []byte) (*Fe, error) {
+	return fromBytes(in)
+}
+
+// FeFromBig constructs a base field element from a big.Int.
+// Input is expected to be non negative and less than the modulus.
+func FeFromBig(in *big.Int) (*Fe, error) {
+	if in.Sign() == -1 {
+		return nil, errors.New("input must be non negative")
+	}
+	return fromBig(in)
+}
+
+// FeFromHex constructs a base field element from a big-endian hex string with optional 0x prefix.
+// Input is expected to be less than the modulus.
+func FeFromHex(in string) (*Fe, error) {
+	return fromString(in)
+}
+
+// Set copies given value into the destination
+func (e *Fe) Set(e2 *Fe) *Fe {
+	return e.set(e2)
+}
+
+// Zero sets the element to zero
+func (e *Fe) Zero() *Fe {
+	return e.zero()
+}
+
+// One sets the element to one
+func (e *Fe) One() *Fe {
+	return e.one()
+}
+
+// Rand sets the element to a uniformly random value
+func (e *Fe) Rand(r io.Reader) (*Fe, error) {
+	if _, err := e.rand(r); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// ToBytes serializes the element into 96 bytes in big-endian canonical form
+func (e *Fe) ToBytes() []byte {
+	return toBytes(e)
+}
+
+// ToBig returns canonical value of the element in big.Int
+func (e *Fe) ToBig() *big.Int {
+	return toBig(e)
+}
+
+// String returns canonical value of the element in hex string
+func (e *Fe) String() string {
+	return toString(e)
+}
+
+// IsZero returns true if the element is equal to zero
+func (e *Fe) IsZero() bool {
+	return e.isZero()
+}
+
+// IsOne returns true if the element is equal to one
+func (e *Fe) IsOne() bool {
+	return e.isOne()
+}
+
+// Equal returns true if given two element is equal, otherwise returns false
+func (e *Fe) Equal(e2 *Fe) bool {
+	return e.equal(e2)
+}
+
+// Add adds two elements `a` and `b` and assigns the result to the element.
+func (e *Fe) Add(a, b *Fe) *Fe {
+	add(e, a, b)
+	return e
+}
+
+// Double doubles the element `a` and assigns the result to the element.
+func (e *Fe) Double(a *Fe) *Fe {
+	double(e, a)
+	return e
+}
+
+// Sub subtracts `b` from `a` and assigns the result to the element.
+func (e *Fe) Sub(a, b *Fe) *Fe {
+	sub(e, a, b)
+	return e
+}
+
+// Neg negates the element `a` and assigns the result to the element.
+func (e *Fe) Neg(a *Fe) *Fe {
+	neg(e, a)
+	return e
+}
+
+// Mul multiplies two elements `a` and `b` and assigns the result to the element.
+func (e *Fe) Mul(a, b *Fe) *Fe {
+	mul(e, a, b)
+	return e
+}
+
+// Square squares the element `a` and assigns the result to the element.
+func (e *Fe) Square(a *Fe) *Fe {
+	square(e, a)
+	return e
+}
+
+// Exp exponents the element `a` by a scalar `s` and assigns the result to the element.
+func (e *Fe) Exp(a *Fe, s *big.Int) *Fe {
+	exp(e, a, s)
+	return e
+}
+
+// Inverse inverses the element `a` and assigns the result to the element.
+// Inverse of zero is zero. Inverse is not constant time, see InverseCT for secret inputs.
+func (e *Fe) Inverse(a *Fe) *Fe {
+	inverse(e, a)
+	return e
+}
+
+// InverseCT inverses the element `a` in constant time and assigns the result to the element.
+func (e *Fe) InverseCT(a *Fe) *Fe {
+	inverseCT(e, a)
+	return e
+}
+
+// Sqrt sets the element to square root of `a` and returns true if `a` is quadratic residue.
+// If `a` is not a quadratic residue the element is left unchanged and false is returned.
+func (e *Fe) Sqrt(a *Fe) bool {
+	r := new(fe)
+	if !sqrt(r, a) {
+		return false
+	}
+	e.set(r)
+	return true
+}
+
+// IsQuadraticNonResidue returns true if the element does not have a square root
+func (e *Fe) IsQuadraticNonResidue() bool {
+	return !e.isZero() && isQuadraticNonResidue(e)
+}
+
+// NewFe3 constructs a cubic extension field element with given coefficients
+func NewFe3(c0, c1, c2 *Fe) *Fe3 {
+	return &fe3{*c0, *c1, *c2}
+}
+
+// Set copies given value into the destination
+func (e *Fe3) Set(e2 *Fe3) *Fe3 {
+	return e.set(e2)
+}
+
+// Zero sets the element to zero
+func (e *Fe3) Zero() *Fe3 {
+	return e.zero()
+}
+
+// One sets the element to one
+func (e *Fe3) One() *Fe3 {
+	return e.one()
+}
+
+// IsZero returns true if the element is equal to zero
+func (e *Fe3) IsZero() bool {
+	return e.isZero()
+}
+
+// IsOne returns true if the element is equal to one
+func (e *Fe3) IsOne() bool {
+	return e.isOne()
+}
+
+// Equal returns true if given two element is equal, otherwise returns false
+func (e *Fe3) Equal(e2 *Fe3) bool {
+	return e.equal(e2)
+}
+
+// NewFe6 constructs a sextic extension field element with given coefficients
+func NewFe6(c0, c1 *Fe3) *Fe6 {
+	return &fe6{*c0, *c1}
+}
+
+// Zero sets the element to zero
+func (e *Fe6) Zero() *Fe6 {
+	return e.zero()
+}
+
+// IsZero returns true if the element is equal to zero
+func (e *Fe6) IsZero() bool {
+	return e.isZero()
+}
+
+// Fp3 is type for cubic extension field. Fp3 holds temporary values for its operations.
+type Fp3 struct {
+	fp3 *fp3
+}
+
+// NewFp3 constructs new cubic extension field instance.
+func NewFp3() *Fp3 {
+	return &Fp3{newFp3()}
+}
+
+// New returns a new element which is equal to zero
+func (e *Fp3) New() *Fe3 {
+	return e.fp3.new()
+}
+
+// One returns a new element which is equal to one
+func (e *Fp3) One() *Fe3 {
+	return e.fp3.one()
+}
+
+// FromBytes expects 288 byte input as concatenation of canonical encodings of the coefficients
+func (e *Fp3) FromBytes(in []byte) (*Fe3, error) {
+	return e.fp3.fromBytes(in)
+}
+
+// ToBytes serializes the element into 288 bytes
+func (e *Fp3) ToBytes(a *Fe3) []byte {
+	return e.fp3.toBytes(a)
+}
+
+// Add adds two field element `a` and `b` and assigns the result to the element in first argument.
+func (e *Fp3) Add(c, a, b *Fe3) {
+	e.fp3.add(c, a, b)
+}
+
+// Double doubles an element `a` and assigns the result to the element in first argument.
+func (e *Fp3) Double(c, a *Fe3) {
+	e.fp3.double(c, a)
+}
+
+// Sub subtracts two field element `a` and `b`, and assigns the result to the element in first argument.
+func (e *Fp3) Sub(c, a, b *Fe3) {
+	e.fp3.sub(c, a, b)
+}
+
+// Neg negates an element `a` and assigns the result to the element in first argument.
+func (e *Fp3) Neg(c, a *Fe3) {
+	e.fp3.neg(c, a)
+}
+
+// Mul multiplies two field element `a` and `b` and assigns the result to the element in first argument.
+func (e *Fp3) Mul(c, a, b *Fe3) {
+	e.fp3.mul(c, a, b)
+}
+
+// Square squares an element `a` and assigns the result to the element in first argument.
+func (e *Fp3) Square(c, a *Fe3) {
+	e.fp3.square(c, a)
+}
+
+// Exp exponents an element `a` by a scalar `s` and assigns the result to the element in first argument.
+func (e *Fp3) Exp(c, a *Fe3, s *big.Int) {
+	e.fp3.exp(c, a, s)
+}
+
+// Inverse inverses an element `a` and assigns the result to the element in first argument.
+func (e *Fp3) Inverse(c, a *Fe3) {
+	e.fp3.inverse(c, a)
+}
+
+// InverseCT inverses an element `a` in constant time and assigns the result to the element in first argument.
+func (e *Fp3) InverseCT(c, a *Fe3) {
+	e.fp3.inverseCT(c, a)
+}
+
+// Frobenius raises an element `a` to p^power and assigns the result to the element in first argument.
+func (e *Fp3) Frobenius(c, a *Fe3, power int) {
+	e.fp3.frobeniusMap(c, a, power)
+}
+
+// Fp6 is type for sextic extension field. Fp6 holds temporary values for its operations.
+type Fp6 struct {
+	fp6 *fp6
+}
+
+// NewFp6 constructs new sextic extension field instance.
+func NewFp6() *Fp6 {
+	return &Fp6{newFp6(nil)}
+}
+
+// New returns a new element which is equal to zero
+func (e *Fp6) New() *Fe6 {
+	return e.fp6.new()
+}
+
+// One returns a new element which is equal to one
+func (e *Fp6) One() *Fe6 {
+	return e.fp6.one()
+}
+
+// FromBytes expects 576 byte input as concatenation of canonical encodings of the coefficients
+func (e *Fp6) FromBytes(in []byte) (*Fe6, error) {
+	return e.fp6.fromBytes(in)
+}
+
+// ToBytes serializes the element into 576 bytes
+func (e *Fp6) ToBytes(a *Fe6) []byte {
+	return e.fp6.toBytes(a)
+}
+
+// Add adds two field element `a` and `b` and assigns the result to the element in first argument.
+func (e *Fp6) Add(c, a, b *Fe6) {
+	e.fp6.add(c, a, b)
+}
+
+// Double doubles an element `a` and assigns the result to the element in first argument.
+func (e *Fp6) Double(c, a *Fe6) {
+	e.fp6.double(c, a)
+}
+
+// Sub subtracts two field element `a` and `b`, and assigns the result to the element in first argument.
+func (e *Fp6) Sub(c, a, b *Fe6) {
+	e.fp6.sub(c, a, b)
+}
+
+// Neg negates an element `a` and assigns the result to the element in first argument.
+func (e *Fp6) Neg(c, a *Fe6) {
+	e.fp6.neg(c, a)
+}
+
+// Conjugate conjugates an element `a` and assigns the result to the element in first argument.
+func (e *Fp6) Conjugate(c, a *Fe6) {
+	e.fp6.conjugate(c, a)
+}
+
+// Mul multiplies two field element `a` and `b` and assigns the result to the element in first argument.
+func (e *Fp6) Mul(c, a, b *Fe6) {
+	e.fp6.mul(c, a, b)
+}
+
+// Square squares an element `a` and assigns the result to the element in first argument.
+func (e *Fp6) Square(c, a *Fe6) {
+	e.fp6.square(c, a)
+}
+
+// Exp exponents an element `a` by a scalar `s` and assigns the result to the element in first argument.
+func (e *Fp6) Exp(c, a *Fe6, s *big.Int) {
+	e.fp6.exp(c, a, s)
+}
+
+// Inverse inverses an element `a` and assigns the result to the element in first argument.
+func (e *Fp6) Inverse(c, a *Fe6) {
+	e.fp6.inverse(c, a)
+}
+
+// InverseCT inverses an element `a` in constant time and assigns the result to the element in first argument.
+func (e *Fp6) InverseCT(c, a *Fe6) {
+	e.fp6.inverseCT(c, a)
+}
+
+// Frobenius raises an element `a` to p^power and assigns the result to the element in first argument.
+func (e *Fp6) Frobenius(c, a *Fe6, power int) {
+	e.fp6.frobeniusMap(c, a, power)
+}
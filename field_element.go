@@ -11,6 +11,7 @@ import (
 type fe /****			***/ [fpNumberOfLimbs]uint64
 type fe3 /***			***/ [3]fe
 type fe6 /***			***/ [2]fe3
+type wfe /***			***/ [2 * fpNumberOfLimbs]uint64
 
 func (e *fe) setBytes(in []byte) *fe {
 	l := len(in)
@@ -35,7 +36,7 @@ func (e *fe) setBig(a *big.Int) *fe {
 }
 
 func (e *fe) setString(s string) (*fe, error) {
-	if s[:2] == "0x" {
+	if len(s) > 1 && s[:2] == "0x" {
 		s = s[2:]
 	}
 	bytes, err := hex.DecodeString(s)
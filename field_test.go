@@ -0,0 +1,232 @@
+package bw6
+
+import (
+	"bytes"
+	"crypto/rand"
+	"math/big"
+	"testing"
+)
+
+func TestFeSerialization(t *testing.T) {
+	for i := 0; i < fuz; i++ {
+		a, _ := new(Fe).Rand(rand.Reader)
+		b, err := FeFromBytes(a.ToBytes())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !a.Equal(b) {
+			t.Fatal("bytes encoding or decoding failed")
+		}
+		b, err = FeFromBig(a.ToBig())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !a.Equal(b) {
+			t.Fatal("big encoding or decoding failed")
+		}
+		b, err = FeFromHex(a.String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !a.Equal(b) {
+			t.Fatal("hex encoding or decoding failed")
+		}
+	}
+	if _, err := FeFromBig(modulus.big()); err == nil {
+		t.Fatal("modulus must be rejected")
+	}
+	if _, err := FeFromBig(big.NewInt(-1)); err == nil {
+		t.Fatal("negative input must be rejected")
+	}
+	if _, err := FeFromBytes(modulus.bytes()); err == nil {
+		t.Fatal("modulus must be rejected")
+	}
+	if _, err := FeFromHex("0xzz"); err == nil {
+		t.Fatal("bad hex string must be rejected")
+	}
+	a, err := FeFromHex("0x04")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !a.Equal(new(Fe).Double(new(Fe).Double(new(Fe).One()))) {
+		t.Fatal("short hex string must be decoded")
+	}
+}
+
+func TestFeArithmeticCrossAgainstBigInt(t *testing.T) {
+	p := modulus.big()
+	for i := 0; i < fuz; i++ {
+		a, _ := new(Fe).Rand(rand.Reader)
+		b, _ := new(Fe).Rand(rand.Reader)
+		big_a, big_b, big_c := a.ToBig(), b.ToBig(), new(big.Int)
+		c := new(Fe)
+		if c.Add(a, b).ToBig().Cmp(big_c.Add(big_a, big_b).Mod(big_c, p)) != 0 {
+			t.Fatal("cross test against big.Int is failed A")
+		}
+		if c.Double(a).ToBig().Cmp(big_c.Add(big_a, big_a).Mod(big_c, p)) != 0 {
+			t.Fatal("cross test against big.Int is failed B")
+		}
+		if c.Sub(a, b).ToBig().Cmp(big_c.Sub(big_a, big_b).Mod(big_c, p)) != 0 {
+			t.Fatal("cross test against big.Int is failed C")
+		}
+		if c.Neg(a).ToBig().Cmp(big_c.Neg(big_a).Mod(big_c, p)) != 0 {
+			t.Fatal("cross test against big.Int is failed D")
+		}
+		if c.Mul(a, b).ToBig().Cmp(big_c.Mul(big_a, big_b).Mod(big_c, p)) != 0 {
+			t.Fatal("cross test against big.Int is failed E")
+		}
+		if c.Square(a).ToBig().Cmp(big_c.Mul(big_a, big_a).Mod(big_c, p)) != 0 {
+			t.Fatal("cross test against big.Int is failed F")
+		}
+		if c.Exp(a, big_b).ToBig().Cmp(big_c.Exp(big_a, big_b, p)) != 0 {
+			t.Fatal("cross test against big.Int is failed G")
+		}
+		if c.Inverse(a).ToBig().Cmp(big_c.ModInverse(big_a, p)) != 0 {
+			t.Fatal("cross test against big.Int is failed H")
+		}
+		if c.InverseCT(a).ToBig().Cmp(big_c) != 0 {
+			t.Fatal("cross test against big.Int is failed I")
+		}
+	}
+}
+
+func TestFeSqrt(t *testing.T) {
+	r := new(Fe)
+	for i := 0; i < fuz; i++ {
+		a, _ := new(Fe).Rand(rand.Reader)
+		aa := new(Fe).Square(a)
+		if aa.IsQuadraticNonResidue() {
+			t.Fatal("a^2 must be quadratic residue")
+		}
+		if !r.Sqrt(aa) {
+			t.Fatal("a^2 must have square root")
+		}
+		if !r.Equal(a) && !r.Equal(new(Fe).Neg(a)) {
+			t.Fatal("sqrt(a^2) == a or -a")
+		}
+		u := new(Fe).Mul(aa, nonResidue1)
+		if !u.IsQuadraticNonResidue() {
+			t.Fatal("a^2 * n must be quadratic non residue")
+		}
+		r.Set(a)
+		if r.Sqrt(u) || !r.Equal(a) {
+			t.Fatal("non residue must not have square root")
+		}
+	}
+}
+
+func TestFp3API(t *testing.T) {
+	field := NewFp3()
+	for i := 0; i < fuz; i++ {
+		a, _ := new(fe3).rand(rand.Reader)
+		b, err := field.FromBytes(field.ToBytes(a))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !a.Equal(b) {
+			t.Fatal("serialization failed")
+		}
+		c := NewFe3(&a[0], &a[1], &a[2])
+		if !c.Equal(a) || !bytes.Equal(field.ToBytes(c)[:fpByteSize], a[0].ToBytes()) {
+			t.Fatal("construction from coefficients failed")
+		}
+		u, v := field.New(), field.New()
+		field.Inverse(u, a)
+		field.Mul(u, u, a)
+		if !u.IsOne() {
+			t.Fatal("a * a^-1 == 1")
+		}
+		field.InverseCT(v, a)
+		field.Inverse(u, a)
+		if !u.Equal(v) {
+			t.Fatal("constant time and variable time inversion must agree")
+		}
+		// frobenius map is equal to a^p
+		field.Exp(u, a, modulus.big())
+		field.Frobenius(v, a, 1)
+		if !u.Equal(v) {
+			t.Fatal("frobenius(a) == a^p")
+		}
+		field.Add(u, a, a)
+		field.Double(v, a)
+		if !u.Equal(v) {
+			t.Fatal("a + a == 2a")
+		}
+		field.Neg(u, a)
+		field.Add(u, u, a)
+		if !u.IsZero() {
+			t.Fatal("a - a == 0")
+		}
+		field.Square(u, a)
+		field.Mul(v, a, a)
+		if !u.Equal(v) {
+			t.Fatal("a^2 == a * a")
+		}
+		field.Sub(u, u, v)
+		if !u.Equal(new(Fe3).Zero()) {
+			t.Fatal("a^2 - a^2 == 0")
+		}
+	}
+	if !field.One().IsOne() || !new(Fe3).Set(field.One()).IsOne() || new(Fe3).One().IsZero() {
+		t.Fatal("one must be one")
+	}
+}
+
+func TestFp6API(t *testing.T) {
+	field := NewFp6()
+	for i := 0; i < fuz; i++ {
+		a, _ := new(fe6).rand(rand.Reader)
+		b, err := field.FromBytes(field.ToBytes(a))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !a.Equal(b) {
+			t.Fatal("serialization failed")
+		}
+		if !NewFe6(&a[0], &a[1]).Equal(a) {
+			t.Fatal("construction from coefficients failed")
+		}
+		u, v := field.New(), field.New()
+		field.Inverse(u, a)
+		field.Mul(u, u, a)
+		if !u.IsOne() {
+			t.Fatal("a * a^-1 == 1")
+		}
+		field.InverseCT(v, a)
+		field.Inverse(u, a)
+		if !u.Equal(v) {
+			t.Fatal("constant time and variable time inversion must agree")
+		}
+		field.Exp(u, a, modulus.big())
+		field.Frobenius(v, a, 1)
+		if !u.Equal(v) {
+			t.Fatal("frobenius(a) == a^p")
+		}
+		// conjugation is equal to a^(p^3)
+		field.Frobenius(u, a, 3)
+		field.Conjugate(v, a)
+		if !u.Equal(v) {
+			t.Fatal("frobenius^3(a) == conjugate(a)")
+		}
+		field.Add(u, a, a)
+		field.Double(v, a)
+		if !u.Equal(v) {
+			t.Fatal("a + a == 2a")
+		}
+		field.Neg(u, a)
+		field.Add(u, u, a)
+		if !u.IsZero() {
+			t.Fatal("a - a == 0")
+		}
+		field.Square(u, a)
+		field.Mul(v, a, a)
+		field.Sub(u, u, v)
+		if !u.Equal(new(Fe6).Zero()) {
+			t.Fatal("a^2 == a * a")
+		}
+	}
+	e := new(Fe6)
+	if !e.One().IsOne() || !e.IsOne() {
+		t.Fatal("one must set the receiver")
+	}
+}
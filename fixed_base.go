@@ -0,0 +1,184 @@
+package bw6
+
+import (
+	"errors"
+	"math/big"
+)
+
+var fixedBaseWindow uint = 8
+
+// fixedBaseTable keeps multiples of a fixed base point in affine form.
+// Scalars are recoded into signed digits of window bit size so that for window i
+// the table holds j * 2^(w * i) * P for j = 1, 2, ..., 2^(w - 1).
+// A multiplication then costs a single mixed addition per window without doublings.
+type fixedBaseTable struct {
+	window uint
+	points []point
+}
+
+func fixedBaseNumberOfWindows(window uint) int {
+	// one extra bit is reserved for the final carry of the signed recoding
+	return (frBitSize + int(window)) / int(window)
+}
+
+func (g *group) newFixedBaseTable(p *point, window uint) *fixedBaseTable {
+	h := 1 << (window - 1)
+	n := fixedBaseNumberOfWindows(window)
+	points := make([]point, n*h)
+	base := new(point).set(p)
+	for i := 0; i < n; i++ {
+		row := points[i*h : (i+1)*h]
+		row[0].set(base)
+		for j := 1; j < h; j++ {
+			g.add(&row[j], &row[j-1], base)
+		}
+		// 2^(w * (i + 1)) * P = 2 * 2^(w - 1) * 2^(w * i) * P
+		g.double(base, &row[h-1])
+	}
+	affine := make([]*point, len(points))
+	for i := 0; i < len(points); i++ {
+		affine[i] = &points[i]
+	}
+	g.affineBatch(affine)
+	return &fixedBaseTable{window, points}
+}
+
+// fixedBaseMul expects the scalar in non Montgomery form and less than q.
+func (g *group) fixedBaseMul(r *point, t *fixedBaseTable, e *Fr) *point {
+	c := int(t.window)
+	h := 1 << (t.window - 1)
+	acc, tmp := new(point).zero(), new(point)
+	carry := 0
+	for i := 0; i < len(t.points)/h; i++ {
+		d := e.window(c*i, c) + carry
+		carry = 0
+		if d > h {
+			d -= 1 << t.window
+			carry = 1
+		}
+		if d > 0 {
+			g.addMixed(acc, acc, &t.points[i*h+d-1])
+		} else if d < 0 {
+			g.neg(tmp, &t.points[i*h-d-1])
+			g.addMixed(acc, acc, tmp)
+		}
+	}
+	return r.set(acc)
+}
+
+// fixedBaseTableToBytes encodes the window size in a single byte followed by table points in uncompressed form.
+func (g *group) fixedBaseTableToBytes(t *fixedBaseTable) []byte {
+	out := make([]byte, 1, 1+len(t.points)*2*fpByteSize)
+	out[0] = byte(t.window)
+	for i := 0; i < len(t.points); i++ {
+		out = append(out, g.toBytes(&t.points[i])...)
+	}
+	return out
+}
+
+// fixedBaseTableFromBytes decodes a table and checks that all points are on curve.
+// Points are not checked to be consistent multiples of the base point.
+func (g *group) fixedBaseTableFromBytes(in []byte) (*fixedBaseTable, error) {
+	if len(in) < 1 {
+		return nil, errors.New("input string must not be empty")
+	}
+	window := uint(in[0])
+	if window < 1 || window > 16 {
+		return nil, errors.New("window size must be in [1, 16]")
+	}
+	n := fixedBaseNumberOfWindows(window) << (window - 1)
+	if len(in) != 1+n*2*fpByteSize {
+		return nil, errors.New("input string length does not match with window size")
+	}
+	points := make([]point, n)
+	for i := 0; i < n; i++ {
+		p, err := g.fromUncompressed(in[1+i*2*fpByteSize : 1+(i+1)*2*fpByteSize])
+		if err != nil {
+			return nil, err
+		}
+		points[i].set(p)
+	}
+	return &fixedBaseTable{window, points}, nil
+}
+
+// FixedBaseG1 keeps precomputed multiples of a G1 point for fast multiplications of the same base.
+// Table size is controlled by fixedBaseWindow and it is about 1.2 MB with the default window.
+type FixedBaseG1 struct {
+	g     *G1
+	table *fixedBaseTable
+}
+
+// NewFixedBase precomputes a table for multiplications of the given G1 point.
+func (g *G1) NewFixedBase(p *PointG1) *FixedBaseG1 {
+	return &FixedBaseG1{g, g.newFixedBaseTable((*point)(p), fixedBaseWindow)}
+}
+
+// FixedBaseFromBytes decodes a precomputed table that is serialized with ToBytes.
+// Input is expected to be from a trusted source since only the curve equation is checked.
+func (g *G1) FixedBaseFromBytes(in []byte) (*FixedBaseG1, error) {
+	t, err := g.fixedBaseTableFromBytes(in)
+	if err != nil {
+		return nil, err
+	}
+	return &FixedBaseG1{g, t}, nil
+}
+
+// ToBytes serializes the precomputed table.
+func (f *FixedBaseG1) ToBytes() []byte {
+	return f.g.fixedBaseTableToBytes(f.table)
+}
+
+// MulScalar multiplies the base point by given scalar value in big.Int and assigns the result to point at first argument.
+func (f *FixedBaseG1) MulScalar(r *PointG1, e *big.Int) *PointG1 {
+	f.g.fixedBaseMul((*point)(r), f.table, frFromBigReduced(e))
+	return r
+}
+
+// MulScalarFr multiplies the base point by given scalar field element and assigns the result to point at first argument.
+func (f *FixedBaseG1) MulScalarFr(r *PointG1, e *Fr) *PointG1 {
+	s := new(Fr)
+	fromMontFR(s, e)
+	f.g.fixedBaseMul((*point)(r), f.table, s)
+	return r
+}
+
+// FixedBaseG2 keeps precomputed multiples of a G2 point for fast multiplications of the same base.
+// Table size is controlled by fixedBaseWindow and it is about 1.2 MB with the default window.
+type FixedBaseG2 struct {
+	g     *G2
+	table *fixedBaseTable
+}
+
+// NewFixedBase precomputes a table for multiplications of the given G2 point.
+func (g *G2) NewFixedBase(p *PointG2) *FixedBaseG2 {
+	return &FixedBaseG2{g, g.newFixedBaseTable((*point)(p), fixedBaseWindow)}
+}
+
+// FixedBaseFromBytes decodes a precomputed table that is serialized with ToBytes.
+// Input is expected to be from a trusted source since only the curve equation is checked.
+func (g *G2) FixedBaseFromBytes(in []byte) (*FixedBaseG2, error) {
+	t, err := g.fixedBaseTableFromBytes(in)
+	if err != nil {
+		return nil, err
+	}
+	return &FixedBaseG2{g, t}, nil
+}
+
+// ToBytes serializes the precomputed table.
+func (f *FixedBaseG2) ToBytes() []byte {
+	return f.g.fixedBaseTableToBytes(f.table)
+}
+
+// MulScalar multiplies the base point by given scalar value in big.Int and assigns the result to point at first argument.
+func (f *FixedBaseG2) MulScalar(r *PointG2, e *big.Int) *PointG2 {
+	f.g.fixedBaseMul((*point)(r), f.table, frFromBigReduced(e))
+	return r
+}
+
+// MulScalarFr multiplies the base point by given scalar field element and assigns the result to point at first argument.
+func (f *FixedBaseG2) MulScalarFr(r *PointG2, e *Fr) *PointG2 {
+	s := new(Fr)
+	fromMontFR(s, e)
+	f.g.fixedBaseMul((*point)(r), f.table, s)
+	return r
+}
@@ -0,0 +1,108 @@
+package bw6
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+)
+
+func TestFixedBaseG1(t *testing.T) {
+	g := NewG1()
+	qMinus1 := new(big.Int).Sub(q, big.NewInt(1))
+	for _, window := range []uint{1, 4, 7, 8} {
+		fixedBaseWindow = window
+		base := g.randCorrect()
+		table := g.NewFixedBase(base)
+		scalars := []*big.Int{big.NewInt(0), big.NewInt(1), big.NewInt(2), qMinus1, q}
+		for i := 0; i < fuz; i++ {
+			scalars = append(scalars, randScalar(q))
+		}
+		for _, s := range scalars {
+			expected, r0, r1 := g.New(), g.New(), g.New()
+			g.MulScalar(expected, base, s)
+			table.MulScalar(r0, s)
+			table.MulScalarFr(r1, FrFromBig(s))
+			if !g.Equal(expected, r0) || !g.Equal(expected, r1) {
+				t.Fatal("fixed base multiplication failed", window, s)
+			}
+		}
+	}
+	fixedBaseWindow = 8
+}
+
+func TestFixedBaseG2(t *testing.T) {
+	g := NewG2()
+	base := g.randCorrect()
+	table := g.NewFixedBase(base)
+	for i := 0; i < fuz; i++ {
+		s := randScalar(q)
+		expected, r := g.New(), g.New()
+		g.MulScalar(expected, base, s)
+		table.MulScalar(r, s)
+		if !g.Equal(expected, r) {
+			t.Fatal("fixed base multiplication failed", s)
+		}
+	}
+}
+
+func TestFixedBaseSerialization(t *testing.T) {
+	fixedBaseWindow = 4
+	defer func() { fixedBaseWindow = 8 }()
+	g := NewG1()
+	table := g.NewFixedBase(g.One())
+	in := table.ToBytes()
+	decoded, err := g.FixedBaseFromBytes(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(in, decoded.ToBytes()) {
+		t.Fatal("bad serialization")
+	}
+	s := randScalar(q)
+	expected, r := g.New(), g.New()
+	g.MulScalar(expected, g.One(), s)
+	decoded.MulScalar(r, s)
+	if !g.Equal(expected, r) {
+		t.Fatal("fixed base multiplication with decoded table failed")
+	}
+	// table of zero point
+	zero, err := g.FixedBaseFromBytes(g.NewFixedBase(g.Zero()).ToBytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !g.IsZero(zero.MulScalar(r, s)) {
+		t.Fatal("multiplication of zero must be zero")
+	}
+	if _, err := NewG2().FixedBaseFromBytes(in); err == nil {
+		t.Fatal("g1 table must be rejected by g2 deserialization")
+	}
+	if _, err := g.FixedBaseFromBytes(in[:len(in)-1]); err == nil {
+		t.Fatal("truncated table must be rejected")
+	}
+	in[0] = 5
+	if _, err := g.FixedBaseFromBytes(in); err == nil {
+		t.Fatal("table with mismatching window must be rejected")
+	}
+}
+
+func BenchmarkFixedBaseG1(t *testing.B) {
+	g := NewG1()
+	s := randScalar(q)
+	res := g.New()
+	t.Run("Precompute", func(t *testing.B) {
+		for i := 0; i < t.N; i++ {
+			g.NewFixedBase(g.One())
+		}
+	})
+	table := g.NewFixedBase(g.One())
+	t.Run("GLV", func(t *testing.B) {
+		for i := 0; i < t.N; i++ {
+			g.MulScalar(res, g.One(), s)
+		}
+	})
+	t.Run("FixedBase", func(t *testing.B) {
+		for i := 0; i < t.N; i++ {
+			table.MulScalar(res, s)
+		}
+	})
+}
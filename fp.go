@@ -68,7 +68,7 @@ func fromMont(c, a *fe) {
 func exp(c, a *fe, e *big.Int) {
 	z := new(fe).set(r1)
 	for i := e.BitLen(); i >= 0; i-- {
-		mul(z, z, z)
+		square(z, z)
 		if e.Bit(i) == 1 {
 			mul(z, z, a)
 		}
@@ -137,6 +137,29 @@ func inverse(inv, e *fe) {
 	inv.set(u)
 }
 
+// inverseCT is constant time alternative of inverse and should be used when
+// the input is secret. Sequence of operations depends only on the public modulus.
+// Inverse of zero is zero.
+func inverseCT(inv, e *fe) {
+	// Fermat's little theorem with fixed window exponentiation
+	// a^-1 = a^(p-2)
+	var table [16]fe
+	table[0].set(r1)
+	table[1].set(e)
+	for i := 2; i < 16; i++ {
+		mul(&table[i], &table[i-1], e)
+	}
+	z := new(fe).set(r1)
+	for i := len(pMinus2Windows) - 1; i >= 0; i-- {
+		square(z, z)
+		square(z, z)
+		square(z, z)
+		square(z, z)
+		mul(z, z, &table[pMinus2Windows[i]])
+	}
+	inv.set(z)
+}
+
 func inverseBatch(in []fe) {
 
 	n, N, setFirst := 0, len(in), false
@@ -7,6 +7,7 @@ import (
 
 type fp3Temp struct {
 	t [6]*fe
+	w [5]*wfe
 }
 
 type fp3 struct {
@@ -15,10 +16,14 @@ type fp3 struct {
 
 func newFp3Temp() fp3Temp {
 	t := [6]*fe{}
+	w := [5]*wfe{}
 	for i := 0; i < len(t); i++ {
 		t[i] = &fe{}
 	}
-	return fp3Temp{t}
+	for i := 0; i < len(w); i++ {
+		w[i] = &wfe{}
+	}
+	return fp3Temp{t, w}
 }
 
 func newFp3() *fp3 {
@@ -132,38 +137,43 @@ func (e *fp3) conjugate(c, a *fe3) {
 func (e *fp3) mul(c, a, b *fe3) {
 	// Guide to Pairing Based Cryptography
 	// Algorithm 5.21
-
-	t := e.t
-	mul(t[0], &a[0], &b[0])  // v0 = a0b0
-	mul(t[1], &a[1], &b[1])  // v1 = a1b1
-	mul(t[2], &a[2], &b[2])  // v2 = a2b2
-	ladd(t[3], &a[1], &a[2]) // a1 + a2
-	ladd(t[4], &b[1], &b[2]) // b1 + b2
-	mul(t[3], t[3], t[4])    // (a1 + a2)(b1 + b2)
-	add(t[4], t[1], t[2])    // v1 + v2
-	subAssign(t[3], t[4])    // (a1 + a2)(b1 + b2) - v1 - v2
-
-	doubleAssign(t[3])
-	doubleAssign(t[3])    // -((a1 + a2)(b1 + b2) - v1 - v2)α
-	sub(t[5], t[0], t[3]) // c0 = ((a1 + a2)(b1 + b2) - v1 - v2)α + v0
-
-	ladd(t[3], &a[0], &a[1]) // a0 + a1
-	ladd(t[4], &b[0], &b[1]) // b0 + b1
-	mul(t[3], t[3], t[4])    // (a0 + a1)(b0 + b1)
-	add(t[4], t[0], t[1])    // v0 + v1
-	sub(t[3], t[3], t[4])    // (a0 + a1)(b0 + b1) - v0 - v1
-
-	double(t[4], t[2])
-	doubleAssign(t[4])     // -αv2
-	sub(&c[1], t[3], t[4]) // c1 = (a0 + a1)(b0 + b1) - v0 - v1 + αv2
-
-	ladd(t[3], &a[0], &a[2]) // a0 + a2
-	ladd(t[4], &b[0], &b[2]) // b0 + b2
-	mul(t[3], t[3], t[4])    // (a0 + a2)(b0 + b2)
-	add(t[4], t[0], t[2])    // v0 + v2
-	sub(t[3], t[3], t[4])    // (a0 + a2)(b0 + b2) - v0 - v2
-	add(&c[2], t[1], t[3])   // c2 = (a0 + a2)(b0 + b2) - v0 - v2 + v1
+	// Products are accumulated in double width and reduced once per coefficient
+
+	t, w := e.t, e.w
+	mulWide(w[0], &a[0], &b[0]) // v0 = a0b0
+	mulWide(w[1], &a[1], &b[1]) // v1 = a1b1
+	mulWide(w[2], &a[2], &b[2]) // v2 = a2b2
+	ladd(t[0], &a[1], &a[2])    // a1 + a2
+	ladd(t[1], &b[1], &b[2])    // b1 + b2
+	mulWide(w[3], t[0], t[1])   // (a1 + a2)(b1 + b2)
+	wadd(w[4], w[1], w[2])      // v1 + v2
+	wsub(w[3], w[3], w[4])      // (a1 + a2)(b1 + b2) - v1 - v2
+
+	wdouble(w[3], w[3])
+	wdouble(w[3], w[3])    // -((a1 + a2)(b1 + b2) - v1 - v2)α
+	wsub(w[3], w[0], w[3]) // ((a1 + a2)(b1 + b2) - v1 - v2)α + v0
+	montRed(t[5], w[3])    // c0
+
+	ladd(t[0], &a[0], &a[1])  // a0 + a1
+	ladd(t[1], &b[0], &b[1])  // b0 + b1
+	mulWide(w[3], t[0], t[1]) // (a0 + a1)(b0 + b1)
+	wadd(w[4], w[0], w[1])    // v0 + v1
+	wsub(w[3], w[3], w[4])    // (a0 + a1)(b0 + b1) - v0 - v1
+
+	wdouble(w[4], w[2])
+	wdouble(w[4], w[4])    // -αv2
+	wsub(w[3], w[3], w[4]) // (a0 + a1)(b0 + b1) - v0 - v1 + αv2
+	montRed(t[4], w[3])    // c1
+
+	ladd(t[0], &a[0], &a[2])  // a0 + a2
+	ladd(t[1], &b[0], &b[2])  // b0 + b2
+	mulWide(w[3], t[0], t[1]) // (a0 + a2)(b0 + b2)
+	wadd(w[4], w[0], w[2])    // v0 + v2
+	wsub(w[3], w[3], w[4])    // (a0 + a2)(b0 + b2) - v0 - v2
+	wadd(w[3], w[3], w[1])    // (a0 + a2)(b0 + b2) - v0 - v2 + v1
+	montRed(&c[2], w[3])      // c2
 	c[0].set(t[5])
+	c[1].set(t[4])
 }
 
 func (e *fp3) square(c, a *fe3) {
@@ -231,6 +241,15 @@ func (e *fp3) exp(c, a *fe3, s *big.Int) {
 }
 
 func (e *fp3) inverse(c, a *fe3) {
+	e.inverseWith(c, a, inverse)
+}
+
+// inverseCT is constant time alternative of inverse
+func (e *fp3) inverseCT(c, a *fe3) {
+	e.inverseWith(c, a, inverseCT)
+}
+
+func (e *fp3) inverseWith(c, a *fe3, inverse func(inv, e *fe)) {
 	// Guide to Pairing Based Cryptography
 	// Algorithm 5.23
 
@@ -8,6 +8,7 @@ import (
 type fp6Temp struct {
 	t3 [4]*fe3
 	t1 [11]*fe
+	w  [2]*wfe
 }
 
 type fp6 struct {
@@ -24,7 +25,8 @@ func newFp6Temp() fp6Temp {
 	for i := 0; i < len(t1); i++ {
 		t1[i] = &fe{}
 	}
-	return fp6Temp{t3, t1}
+	w := [2]*wfe{&wfe{}, &wfe{}}
+	return fp6Temp{t3, t1, w}
 }
 
 func newFp6(f *fp3) *fp6 {
@@ -148,8 +150,10 @@ func (e *fp6) mul(c, a, b *fe6) {
 }
 
 func (e *fp6) mulBy014Assign(a *fe6, c0, c1, c4 *fe) {
+	// Each coefficient is a sum of three products
+	// which is accumulated in double width and reduced once
 
-	t := e.t1
+	t, w := e.t1, e.w
 
 	t[6].set(&a[0][0])
 	t[7].set(&a[0][1])
@@ -161,48 +165,51 @@ func (e *fp6) mulBy014Assign(a *fe6, c0, c1, c4 *fe) {
 	doubleAssign(t[4])
 	neg(t[4], t[4])
 
-	mul(t[1], t[4], t[8])
-
 	double(t[5], c4)
 	doubleAssign(t[5])
 	neg(t[5], t[5])
-	mul(t[2], t[5], t[10])
-
-	mul(t[0], c0, t[6])
-
-	add(&a[0][0], t[0], t[1])
-	addAssign(&a[0][0], t[2])
-
-	mul(t[0], c0, t[7])
-
-	mul(t[1], c1, t[6])
-	mul(t[2], t[5], &a[1][2])
-	add(&a[0][1], t[0], t[1])
-	addAssign(&a[0][1], t[2])
-
-	mul(t[0], c0, t[8])
-	mul(t[1], c1, t[7])
-	mul(t[2], c4, t[9])
-	add(&a[0][2], t[0], t[1])
-	addAssign(&a[0][2], t[2])
-
-	mul(t[0], c0, t[9])
-	mul(t[1], t[4], &a[1][2])
-	mul(t[2], t[5], t[8])
-	add(&a[1][0], t[0], t[1])
-	addAssign(&a[1][0], t[2])
-
-	mul(t[0], c0, t[10])
-	mul(t[1], c1, t[9])
-	mul(t[2], c4, t[6])
-	add(&a[1][1], t[0], t[1])
-	addAssign(&a[1][1], t[2])
-
-	mul(t[0], c0, &a[1][2])
-	mul(t[1], c1, t[10])
-	mul(t[2], c4, t[7])
-	add(&a[1][2], t[0], t[1])
-	addAssign(&a[1][2], t[2])
+
+	mulWide(w[0], c0, t[6])
+	mulWide(w[1], t[4], t[8])
+	wadd(w[0], w[0], w[1])
+	mulWide(w[1], t[5], t[10])
+	wadd(w[0], w[0], w[1])
+	montRed(&a[0][0], w[0])
+
+	mulWide(w[0], c0, t[7])
+	mulWide(w[1], c1, t[6])
+	wadd(w[0], w[0], w[1])
+	mulWide(w[1], t[5], &a[1][2])
+	wadd(w[0], w[0], w[1])
+	montRed(&a[0][1], w[0])
+
+	mulWide(w[0], c0, t[8])
+	mulWide(w[1], c1, t[7])
+	wadd(w[0], w[0], w[1])
+	mulWide(w[1], c4, t[9])
+	wadd(w[0], w[0], w[1])
+	montRed(&a[0][2], w[0])
+
+	mulWide(w[0], c0, t[9])
+	mulWide(w[1], t[4], &a[1][2])
+	wadd(w[0], w[0], w[1])
+	mulWide(w[1], t[5], t[8])
+	wadd(w[0], w[0], w[1])
+	montRed(&a[1][0], w[0])
+
+	mulWide(w[0], c0, t[10])
+	mulWide(w[1], c1, t[9])
+	wadd(w[0], w[0], w[1])
+	mulWide(w[1], c4, t[6])
+	wadd(w[0], w[0], w[1])
+	montRed(&a[1][1], w[0])
+
+	mulWide(w[0], c0, &a[1][2])
+	mulWide(w[1], c1, t[10])
+	wadd(w[0], w[0], w[1])
+	mulWide(w[1], c4, t[7])
+	wadd(w[0], w[0], w[1])
+	montRed(&a[1][2], w[0])
 }
 
 func (e *fp6) fp2Square(c0, c1, a0, a1 *fe) {
@@ -318,6 +325,15 @@ func (e *fp6) cyclotomicSquaring(c, a *fe6) {
 }
 
 func (e *fp6) inverse(c, a *fe6) {
+	e.inverseWith(c, a, e.fp3.inverse)
+}
+
+// inverseCT is constant time alternative of inverse
+func (e *fp6) inverseCT(c, a *fe6) {
+	e.inverseWith(c, a, e.fp3.inverseCT)
+}
+
+func (e *fp6) inverseWith(c, a *fe6, inverse func(c, a *fe3)) {
 	// Guide to Pairing Based Cryptography
 	// Algorithm 5.19
 
@@ -328,7 +344,7 @@ func (e *fp6) inverse(c, a *fe6) {
 
 	fp3.mulByNonResidue(t[2], t[1])
 	fp3.sub(t[0], t[0], t[2]) // v = a0^2 + ßa1^2
-	fp3.inverse(t[1], t[0])   // v = v^-1
+	inverse(t[1], t[0])       // v = v^-1
 
 	fp3.mul(&c[0], t[1], &a[0]) // a0v
 	fp3.mul(t[1], t[1], &a[1])  // a1v
@@ -299,6 +299,166 @@ func TestFpLazyOperations(t *testing.T) {
 	}
 }
 
+// fpEdgeCases returns operands close to zero and modulus which exercise carry and borrow handling of the backend.
+func fpEdgeCases() []*big.Int {
+	p := modulus.big()
+	return []*big.Int{
+		big.NewInt(0),
+		big.NewInt(1),
+		big.NewInt(2),
+		new(big.Int).Sub(p, big.NewInt(1)),
+		new(big.Int).Sub(p, big.NewInt(2)),
+		new(big.Int).Rsh(p, 1),
+		new(big.Int).Lsh(big.NewInt(1), 64),
+		new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 704), big.NewInt(1)),
+	}
+}
+
+func TestFpArithmeticEdgeCases(t *testing.T) {
+	p := modulus.big()
+	values := fpEdgeCases()
+	r := new(big.Int).Lsh(big.NewInt(1), 768)
+	rInv := new(big.Int).ModInverse(r, p)
+	for _, big_a := range values {
+		a, c := new(fe).setBig(big_a), new(fe)
+		square(c, a)
+		big_c := new(big.Int).Mul(big_a, big_a)
+		if c.big().Cmp(big_c.Mul(big_c, rInv).Mod(big_c, p)) != 0 {
+			t.Fatal("edge case failed for squaring")
+		}
+		for _, big_b := range values {
+			a, b, c := new(fe).setBig(big_a), new(fe).setBig(big_b), new(fe)
+			big_c := new(big.Int)
+			add(c, a, b)
+			if c.big().Cmp(big_c.Add(big_a, big_b).Mod(big_c, p)) != 0 {
+				t.Fatal("edge case failed for addition")
+			}
+			double(c, a)
+			if c.big().Cmp(big_c.Add(big_a, big_a).Mod(big_c, p)) != 0 {
+				t.Fatal("edge case failed for doubling")
+			}
+			sub(c, a, b)
+			if c.big().Cmp(big_c.Sub(big_a, big_b).Mod(big_c, p)) != 0 {
+				t.Fatal("edge case failed for subtraction")
+			}
+			neg(c, a)
+			if c.big().Cmp(big_c.Neg(big_a).Mod(big_c, p)) != 0 {
+				t.Fatal("edge case failed for negation")
+			}
+			ladd(c, a, b)
+			if c.big().Cmp(big_c.Add(big_a, big_b)) != 0 {
+				t.Fatal("edge case failed for lazy addition")
+			}
+			ldouble(c, a)
+			if c.big().Cmp(big_c.Add(big_a, big_a)) != 0 {
+				t.Fatal("edge case failed for lazy doubling")
+			}
+			if big_a.Cmp(big_b) >= 0 {
+				lsub(c, a, b)
+				if c.big().Cmp(big_c.Sub(big_a, big_b)) != 0 {
+					t.Fatal("edge case failed for lazy subtraction")
+				}
+			}
+			// montgomery multiplication of raw values is a * b * r^-1
+			mul(c, a, b)
+			big_c.Mul(big_a, big_b).Mul(big_c, rInv).Mod(big_c, p)
+			if c.big().Cmp(big_c) != 0 {
+				t.Fatal("edge case failed for multiplication")
+			}
+		}
+	}
+}
+
+func TestFpArithmeticAgainstGeneric(t *testing.T) {
+	// backend in use is compared against native go implementations in the same binary
+	// lazy subtraction expects a >= b
+	ordered := func(a, b *fe) (*fe, *fe) {
+		if a.cmp(b) < 0 {
+			return b, a
+		}
+		return a, b
+	}
+	values := []*fe{}
+	for _, v := range fpEdgeCases() {
+		values = append(values, new(fe).setBig(v))
+	}
+	for i := 0; i < fuz; i++ {
+		a, _ := new(fe).rand(rand.Reader)
+		values = append(values, a)
+	}
+	ops := []struct {
+		name              string
+		backend, fallback func(c, a, b *fe)
+	}{
+		{"add", add, addGeneric},
+		{"double", func(c, a, _ *fe) { double(c, a) }, func(c, a, _ *fe) { doubleGeneric(c, a) }},
+		{"sub", sub, subGeneric},
+		{"neg", func(c, a, _ *fe) { neg(c, a) }, func(c, a, _ *fe) { negGeneric(c, a) }},
+		{"mul", mul, mulGeneric},
+		{"square", func(c, a, _ *fe) { square(c, a) }, func(c, a, _ *fe) { squareGeneric(c, a) }},
+		{"ladd", ladd, laddGeneric},
+		{"ldouble", func(c, a, _ *fe) { ldouble(c, a) }, func(c, a, _ *fe) { ldoubleGeneric(c, a) }},
+		{"lsub", func(c, a, b *fe) {
+			a, b = ordered(a, b)
+			lsub(c, a, b)
+		}, func(c, a, b *fe) {
+			a, b = ordered(a, b)
+			lsubGeneric(c, a, b)
+		}},
+		{"addAssign", func(c, a, b *fe) { addAssign(c.set(a), b) }, func(c, a, b *fe) { addAssignGeneric(c.set(a), b) }},
+		{"doubleAssign", func(c, a, _ *fe) { doubleAssign(c.set(a)) }, func(c, a, _ *fe) { doubleAssignGeneric(c.set(a)) }},
+		{"subAssign", func(c, a, b *fe) { subAssign(c.set(a), b) }, func(c, a, b *fe) { subAssignGeneric(c.set(a), b) }},
+		{"laddAssign", func(c, a, b *fe) { laddAssign(c.set(a), b) }, func(c, a, b *fe) { laddAssignGeneric(c.set(a), b) }},
+		{"ldoubleAssign", func(c, a, _ *fe) { ldoubleAssign(c.set(a)) }, func(c, a, _ *fe) { ldoubleAssignGeneric(c.set(a)) }},
+		{"lsubAssign", func(c, a, b *fe) {
+			a, b = ordered(a, b)
+			lsubAssign(c.set(a), b)
+		}, func(c, a, b *fe) {
+			a, b = ordered(a, b)
+			lsubAssignGeneric(c.set(a), b)
+		}},
+	}
+	for _, a := range values {
+		for _, b := range values {
+			c0, c1 := new(fe), new(fe)
+			for _, op := range ops {
+				op.backend(c0, a, b)
+				op.fallback(c1, a, b)
+				if !c0.equal(c1) {
+					t.Fatal("backend and native go implementation differ", op.name)
+				}
+			}
+			w0, w1, w2, w3 := new(wfe), new(wfe), new(wfe), new(wfe)
+			mulWide(w0, a, b)
+			mulWideGeneric(w1, a, b)
+			if *w0 != *w1 {
+				t.Fatal("backend and native go implementation differ", "mulWide")
+			}
+			montRed(c0, w0)
+			montRedGeneric(c1, w1)
+			if !c0.equal(c1) {
+				t.Fatal("backend and native go implementation differ", "montRed")
+			}
+			mulWide(w1, b, b)
+			wadd(w2, w0, w1)
+			waddGeneric(w3, w0, w1)
+			if *w2 != *w3 {
+				t.Fatal("backend and native go implementation differ", "wadd")
+			}
+			wdouble(w2, w0)
+			wdoubleGeneric(w3, w0)
+			if *w2 != *w3 {
+				t.Fatal("backend and native go implementation differ", "wdouble")
+			}
+			wsub(w2, w0, w1)
+			wsubGeneric(w3, w0, w1)
+			if *w2 != *w3 {
+				t.Fatal("backend and native go implementation differ", "wsub")
+			}
+		}
+	}
+}
+
 func TestFpMultiplicationCrossAgainstBigInt(t *testing.T) {
 	for i := 0; i < fuz; i++ {
 		a, _ := new(fe).rand(rand.Reader)
@@ -316,6 +476,92 @@ func TestFpMultiplicationCrossAgainstBigInt(t *testing.T) {
 	}
 }
 
+func TestFpSquaringCrossAgainstBigInt(t *testing.T) {
+	for i := 0; i < fuz; i++ {
+		a, _ := new(fe).rand(rand.Reader)
+		c := new(fe)
+		big_a := toBig(a)
+		big_c := new(big.Int)
+		square(c, a)
+		out_1 := toBytes(c)
+		out_2 := padBytes(big_c.Mul(big_a, big_a).Mod(big_c, modulus.big()).Bytes(), fpByteSize)
+		if !bytes.Equal(out_1, out_2) {
+			t.Fatal("cross test against big.Int is failed")
+		}
+		square(a, a)
+		if !a.equal(c) {
+			t.Fatal("squaring in place failed")
+		}
+	}
+}
+
+func TestFpWideMultiplication(t *testing.T) {
+	wideBig := func(w *wfe) *big.Int {
+		lo, hi := new(fe), new(fe)
+		copy(lo[:], w[:fpNumberOfLimbs])
+		copy(hi[:], w[fpNumberOfLimbs:])
+		return new(big.Int).Add(new(big.Int).Lsh(hi.big(), 768), lo.big())
+	}
+	pR := new(big.Int).Lsh(modulus.big(), 768)
+	for i := 0; i < fuz; i++ {
+		a, _ := new(fe).rand(rand.Reader)
+		b, _ := new(fe).rand(rand.Reader)
+		w0, w1, w2 := new(wfe), new(wfe), new(wfe)
+		mulWide(w0, a, b)
+		if wideBig(w0).Cmp(new(big.Int).Mul(a.big(), b.big())) != 0 {
+			t.Fatal("cross test against big.Int is failed")
+		}
+		c0, c1 := new(fe), new(fe)
+		montRed(c0, w0)
+		mul(c1, a, b)
+		if !c0.equal(c1) {
+			t.Fatal("montgomery reduction of wide product failed")
+		}
+		// (a * b + a * b - a * a) % q == 2 * a * b - a * a
+		mulWide(w1, a, a)
+		wadd(w2, w0, w0)
+		wsub(w2, w2, w1)
+		montRed(c0, w2)
+		wdouble(w2, w0)
+		wsub(w2, w2, w1)
+		montRed(c1, w2)
+		if !c0.equal(c1) {
+			t.Fatal("2 * a * b == a * b + a * b")
+		}
+		mul(c1, a, a)
+		double(a, a)
+		mul(a, a, b)
+		subAssign(a, c1)
+		if !a.equal(c0) {
+			t.Fatal("lazy reduced sum of products failed")
+		}
+		// subtraction underflow is compensated with q * 2^768
+		wsub(w2, w1, w0)
+		want := new(big.Int).Sub(wideBig(w1), wideBig(w0))
+		if want.Sign() < 0 {
+			want.Add(want, pR)
+		}
+		if wideBig(w2).Cmp(want) != 0 {
+			t.Fatal("wide subtraction failed")
+		}
+	}
+	// largest input montgomery reduction accepts is q * 2^768 - 1
+	w := new(wfe)
+	for i := 0; i < fpNumberOfLimbs; i++ {
+		w[i] = ^uint64(0)
+	}
+	copy(w[fpNumberOfLimbs:], modulus[:])
+	w[fpNumberOfLimbs]--
+	c := new(fe)
+	montRed(c, w)
+	rInv := new(big.Int).ModInverse(new(big.Int).Lsh(big.NewInt(1), 768), modulus.big())
+	want := wideBig(w)
+	want.Mul(want, rInv).Mod(want, modulus.big())
+	if c.big().Cmp(want) != 0 {
+		t.Fatal("montgomery reduction of the largest input failed")
+	}
+}
+
 func TestFpMultiplicationProperties(t *testing.T) {
 	for i := 0; i < fuz; i++ {
 		a, _ := new(fe).rand(rand.Reader)
@@ -354,7 +600,7 @@ func TestFpMultiplicationProperties(t *testing.T) {
 		_, _ = a.rand(rand.Reader)
 		square(c1, a)
 		mul(c2, a, a)
-		if !c1.equal(c1) {
+		if !c1.equal(c2) {
 			t.Fatal("a^2 == a*a")
 		}
 		_, _ = a.rand(rand.Reader)
@@ -431,6 +677,39 @@ func TestFpInversion(t *testing.T) {
 	}
 }
 
+func TestFpInversionConstantTime(t *testing.T) {
+	u, v := new(fe), new(fe)
+	zero, one := new(fe).zero(), new(fe).one()
+	inverseCT(u, zero)
+	if !u.equal(zero) {
+		t.Fatal("(0^-1) == 0)")
+	}
+	inverseCT(u, one)
+	if !u.equal(one) {
+		t.Fatal("(1^-1) == 1)")
+	}
+	pMinus1 := new(fe).set(&modulus)
+	lsubAssign(pMinus1, &fe{1})
+	toMont(pMinus1, pMinus1)
+	inverseCT(u, pMinus1)
+	inverse(v, pMinus1)
+	if !u.equal(v) {
+		t.Fatal("constant time and variable time inversion must agree")
+	}
+	for i := 0; i < fuz; i++ {
+		a, _ := new(fe).rand(rand.Reader)
+		inverseCT(u, a)
+		inverse(v, a)
+		if !u.equal(v) {
+			t.Fatal("constant time and variable time inversion must agree")
+		}
+		mul(u, u, a)
+		if !u.equal(one) {
+			t.Fatal("(r*a) * r*(a^-1) == r)")
+		}
+	}
+}
+
 func TestFpSquareRoot(t *testing.T) {
 	r := new(fe)
 	if sqrt(r, nonResidue1) {
@@ -680,6 +959,16 @@ func TestFp3Inversion(t *testing.T) {
 		if !u.equal(one) {
 			t.Fatal("(r * a) * r * (a ^ -1) == r)")
 		}
+		v := field.new()
+		field.inverse(u, a)
+		field.inverseCT(v, a)
+		if !u.equal(v) {
+			t.Fatal("constant time and variable time inversion must agree")
+		}
+	}
+	field.inverseCT(u, zero)
+	if !u.equal(zero) {
+		t.Fatal("(0 ^ -1) == 0)")
 	}
 }
 
@@ -887,6 +1176,16 @@ func TestFp6Inversion(t *testing.T) {
 		if !u.equal(one) {
 			t.Fatal("(r * a) * r * (a ^ -1) == r)")
 		}
+		v := field.new()
+		field.inverse(u, a)
+		field.inverseCT(v, a)
+		if !u.equal(v) {
+			t.Fatal("constant time and variable time inversion must agree")
+		}
+	}
+	field.inverseCT(u, zero)
+	if !u.equal(zero) {
+		t.Fatal("(0 ^ -1) == 0)")
 	}
 }
 
@@ -1005,6 +1304,16 @@ func BenchmarkMul(t *testing.B) {
 	_ = c
 }
 
+func BenchmarkSquare(t *testing.B) {
+	a, _ := new(fe).rand(rand.Reader)
+	c := new(fe)
+	t.ResetTimer()
+	for i := 0; i < t.N; i++ {
+		square(c, a)
+	}
+	_ = c
+}
+
 func BenchmarkInv(t *testing.B) {
 	a, _ := new(fe).rand(rand.Reader)
 	c := new(fe)
@@ -1014,3 +1323,13 @@ func BenchmarkInv(t *testing.B) {
 	}
 	_ = c
 }
+
+func BenchmarkInvCT(t *testing.B) {
+	a, _ := new(fe).rand(rand.Reader)
+	c := new(fe)
+	t.ResetTimer()
+	for i := 0; i < t.N; i++ {
+		inverseCT(c, a)
+	}
+	_ = c
+}
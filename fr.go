@@ -0,0 +1,354 @@
+package bw6
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+)
+
+// Fr is type for scalar field element. Elements are kept in Montgomery form
+// and modulus of the field is the group order q.
+type Fr [frNumberOfLimbs]uint64
+
+// NewFr returns a new scalar field element which is equal to zero.
+func NewFr() *Fr {
+	return new(Fr)
+}
+
+// FrFromBytes constructs a scalar field element from 48 bytes big-endian input.
+// Input is expected to be in canonical form that is less than the group order.
+func FrFromBytes(in []byte) (*Fr, error) {
+	if len(in) != frByteSize {
+		return nil, errors.New("input string length must be equal to 48 bytes")
+	}
+	e := new(Fr).setBytes(in)
+	if !e.isValid() {
+		return nil, errors.New("must be less than modulus")
+	}
+	toMontFR(e, e)
+	return e, nil
+}
+
+// FrFromBig constructs a scalar field element from a big.Int.
+// Input is reduced by the group order, negative values are also accepted.
+func FrFromBig(in *big.Int) *Fr {
+	return new(Fr).SetBig(in)
+}
+
+// Set copies given value into the destination
+func (e *Fr) Set(e2 *Fr) *Fr {
+	e[0] = e2[0]
+	e[1] = e2[1]
+	e[2] = e2[2]
+	e[3] = e2[3]
+	e[4] = e2[4]
+	e[5] = e2[5]
+	return e
+}
+
+// Zero sets the element to zero
+func (e *Fr) Zero() *Fr {
+	e[0] = 0
+	e[1] = 0
+	e[2] = 0
+	e[3] = 0
+	e[4] = 0
+	e[5] = 0
+	return e
+}
+
+// One sets the element to one
+func (e *Fr) One() *Fr {
+	return e.Set(frR1)
+}
+
+// SetUint64 sets the element to given small integer
+func (e *Fr) SetUint64(n uint64) *Fr {
+	e.Zero()[0] = n
+	toMontFR(e, e)
+	return e
+}
+
+// SetBig sets the element to given big.Int reduced by the group order
+func (e *Fr) SetBig(a *big.Int) *Fr {
+	b := new(big.Int).Mod(a, q)
+	e.setBig(b)
+	toMontFR(e, e)
+	return e
+}
+
+// Rand sets the element to a uniformly random value
+func (e *Fr) Rand(r io.Reader) (*Fr, error) {
+	bi, err := rand.Int(r, q)
+	if err != nil {
+		return nil, err
+	}
+	e.setBig(bi)
+	toMontFR(e, e)
+	return e, nil
+}
+
+// ToBytes serializes the element into 48 bytes in big-endian canonical form
+func (e *Fr) ToBytes() []byte {
+	e2 := new(Fr)
+	fromMontFR(e2, e)
+	return e2.bytes()
+}
+
+// ToBig returns canonical value of the element in big.Int
+func (e *Fr) ToBig() *big.Int {
+	e2 := new(Fr)
+	fromMontFR(e2, e)
+	return e2.big()
+}
+
+// String returns canonical value of the element in hex string
+func (e *Fr) String() string {
+	e2 := new(Fr)
+	fromMontFR(e2, e)
+	return e2.string()
+}
+
+// IsZero returns true if the element is equal to zero
+func (e *Fr) IsZero() bool {
+	return (e[5] | e[4] | e[3] | e[2] | e[1] | e[0]) == 0
+}
+
+// IsOne returns true if the element is equal to one
+func (e *Fr) IsOne() bool {
+	return e.Equal(frR1)
+}
+
+// Equal returns true if given two element is equal, otherwise returns false
+func (e *Fr) Equal(e2 *Fr) bool {
+	return e2[0] == e[0] && e2[1] == e[1] && e2[2] == e[2] && e2[3] == e[3] && e2[4] == e[4] && e2[5] == e[5]
+}
+
+// Add adds two elements `a` and `b` and assigns the result to the element.
+func (e *Fr) Add(a, b *Fr) *Fr {
+	addFR(e, a, b)
+	return e
+}
+
+// Double doubles the element `a` and assigns the result to the element.
+func (e *Fr) Double(a *Fr) *Fr {
+	doubleFR(e, a)
+	return e
+}
+
+// Sub subtracts `b` from `a` and assigns the result to the element.
+func (e *Fr) Sub(a, b *Fr) *Fr {
+	subFR(e, a, b)
+	return e
+}
+
+// Neg negates the element `a` and assigns the result to the element.
+func (e *Fr) Neg(a *Fr) *Fr {
+	negFR(e, a)
+	return e
+}
+
+// Mul multiplies two elements `a` and `b` and assigns the result to the element.
+func (e *Fr) Mul(a, b *Fr) *Fr {
+	mulFR(e, a, b)
+	return e
+}
+
+// Square squares the element `a` and assigns the result to the element.
+func (e *Fr) Square(a *Fr) *Fr {
+	squareFR(e, a)
+	return e
+}
+
+// Exp exponents the element `a` by a scalar `s` and assigns the result to the element.
+func (e *Fr) Exp(a *Fr, s *big.Int) *Fr {
+	expFR(e, a, s)
+	return e
+}
+
+// Inverse inverses the element `a` and assigns the result to the element.
+// Inverse of zero is zero.
+func (e *Fr) Inverse(a *Fr) *Fr {
+	inverseFR(e, a)
+	return e
+}
+
+// Sqrt sets the element to square root of `a` and returns true if `a` is quadratic residue.
+// If `a` is not a quadratic residue the element is left unchanged and false is returned.
+func (e *Fr) Sqrt(a *Fr) bool {
+	return sqrtFR(e, a)
+}
+
+// Legendre returns Legendre symbol of the element which is
+// 1 for quadratic residues, -1 for non residues and 0 for zero.
+func (e *Fr) Legendre() int {
+	return legendreFR(e)
+}
+
+// setBytes sets the element to big-endian input as is. Input longer than 48 bytes is reduced by the group order.
+func (e *Fr) setBytes(in []byte) *Fr {
+	if len(in) > frByteSize {
+		in = new(big.Int).Mod(new(big.Int).SetBytes(in), q).Bytes()
+	}
+	l := len(in)
+	padded := make([]byte, frByteSize)
+	copy(padded[frByteSize-l:], in[:])
+	var a int
+	for i := 0; i < frNumberOfLimbs; i++ {
+		a = frByteSize - i*8
+		e[i] = uint64(padded[a-1]) | uint64(padded[a-2])<<8 |
+			uint64(padded[a-3])<<16 | uint64(padded[a-4])<<24 |
+			uint64(padded[a-5])<<32 | uint64(padded[a-6])<<40 |
+			uint64(padded[a-7])<<48 | uint64(padded[a-8])<<56
+	}
+	return e
+}
+
+func (e *Fr) setBig(a *big.Int) *Fr {
+	return e.setBytes(a.Bytes())
+}
+
+// frFromBigReduced returns the scalar reduced modulo q in non Montgomery form.
+func frFromBigReduced(e *big.Int) *Fr {
+	s := new(Fr)
+	fromMontFR(s, FrFromBig(e))
+	return s
+}
+
+func (e *Fr) bytes() []byte {
+	out := make([]byte, frByteSize)
+	var a int
+	for i := 0; i < frNumberOfLimbs; i++ {
+		a = frByteSize - i*8
+		out[a-1] = byte(e[i])
+		out[a-2] = byte(e[i] >> 8)
+		out[a-3] = byte(e[i] >> 16)
+		out[a-4] = byte(e[i] >> 24)
+		out[a-5] = byte(e[i] >> 32)
+		out[a-6] = byte(e[i] >> 40)
+		out[a-7] = byte(e[i] >> 48)
+		out[a-8] = byte(e[i] >> 56)
+	}
+	return out
+}
+
+func (e *Fr) big() *big.Int {
+	return new(big.Int).SetBytes(e.bytes())
+}
+
+func (e *Fr) string() (s string) {
+	for i := frNumberOfLimbs - 1; i >= 0; i-- {
+		s = fmt.Sprintf("%s%16.16x", s, e[i])
+	}
+	return "0x" + s
+}
+
+func (e *Fr) isValid() bool {
+	return e.cmp(&frModulus) == -1
+}
+
+func (e *Fr) cmp(e2 *Fr) int {
+	for i := frNumberOfLimbs - 1; i >= 0; i-- {
+		if e[i] > e2[i] {
+			return 1
+		} else if e[i] < e2[i] {
+			return -1
+		}
+	}
+	return 0
+}
+
+// window returns c bits starting from position i of an element in non Montgomery form
+func (e *Fr) window(i, c int) int {
+	limb, shift := i/64, uint(i%64)
+	if limb >= frNumberOfLimbs {
+		return 0
+	}
+	w := e[limb] >> shift
+	if shift+uint(c) > 64 && limb+1 < frNumberOfLimbs {
+		w |= e[limb+1] << (64 - shift)
+	}
+	return int(w & ((1 << uint(c)) - 1))
+}
+
+func toMontFR(c, a *Fr) {
+	mulFR(c, a, frR2)
+}
+
+func fromMontFR(c, a *Fr) {
+	mulFR(c, a, &Fr{1})
+}
+
+func expFR(c, a *Fr, e *big.Int) {
+	z := new(Fr).Set(frR1)
+	for i := e.BitLen(); i >= 0; i-- {
+		squareFR(z, z)
+		if e.Bit(i) == 1 {
+			mulFR(z, z, a)
+		}
+	}
+	c.Set(z)
+}
+
+func inverseFR(c, a *Fr) {
+	// Fermat's little theorem
+	// a^-1 = a^(q-2)
+	expFR(c, a, qMinus2)
+}
+
+func legendreFR(a *Fr) int {
+	if a.IsZero() {
+		return 0
+	}
+	// Euler's criterion
+	// a^((q-1)/2)
+	u := new(Fr)
+	expFR(u, a, qMinus1Over2)
+	if u.IsOne() {
+		return 1
+	}
+	return -1
+}
+
+func sqrtFR(c, a *Fr) bool {
+	// Tonelli-Shanks
+	// q - 1 = 2^s * t
+	if a.IsZero() {
+		c.Zero()
+		return true
+	}
+	w, x, b, z := new(Fr), new(Fr), new(Fr), new(Fr).Set(frRootOfUnity)
+	// w = a^((t-1)/2)
+	// x = a^((t+1)/2)
+	// b = a^t
+	expFR(w, a, qOddFactorMinus1Over2)
+	mulFR(x, a, w)
+	mulFR(b, x, w)
+	v := frTwoAdicity
+	t := new(Fr)
+	for !b.IsOne() {
+		// find least m such that b^(2^m) = 1
+		m := 0
+		t.Set(b)
+		for !t.IsOne() {
+			squareFR(t, t)
+			m++
+			if m == v {
+				return false
+			}
+		}
+		// w = z^(2^(v-m-1))
+		w.Set(z)
+		for i := 0; i < v-m-1; i++ {
+			squareFR(w, w)
+		}
+		squareFR(z, w)
+		mulFR(b, b, z)
+		mulFR(x, x, w)
+		v = m
+	}
+	c.Set(x)
+	return true
+}
@@ -0,0 +1,280 @@
+package bw6
+
+import (
+	"bytes"
+	"crypto/rand"
+	"math/big"
+	"testing"
+)
+
+func TestFrSerialization(t *testing.T) {
+	t.Run("zero", func(t *testing.T) {
+		in := make([]byte, frByteSize)
+		e, err := FrFromBytes(in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !e.IsZero() {
+			t.Fatal("serialization failed")
+		}
+		if !bytes.Equal(in, e.ToBytes()) {
+			t.Fatal("serialization failed")
+		}
+	})
+	t.Run("bytes", func(t *testing.T) {
+		for i := 0; i < fuz; i++ {
+			a, _ := new(Fr).Rand(rand.Reader)
+			b, err := FrFromBytes(a.ToBytes())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !a.Equal(b) {
+				t.Fatal("serialization failed")
+			}
+		}
+	})
+	t.Run("big", func(t *testing.T) {
+		for i := 0; i < fuz; i++ {
+			a, _ := new(Fr).Rand(rand.Reader)
+			b := FrFromBig(a.ToBig())
+			if !a.Equal(b) {
+				t.Fatal("encoding or decoding failed")
+			}
+		}
+	})
+	t.Run("non canonical", func(t *testing.T) {
+		if _, err := FrFromBytes(padBytes(q.Bytes(), frByteSize)); err == nil {
+			t.Fatal("modulus must be rejected")
+		}
+		if _, err := FrFromBytes(make([]byte, frByteSize+1)); err == nil {
+			t.Fatal("bad input length must be rejected")
+		}
+	})
+	t.Run("reduction", func(t *testing.T) {
+		a := randScalar(q)
+		b := new(big.Int).Add(a, q)
+		if !FrFromBig(a).Equal(FrFromBig(b)) {
+			t.Fatal("a + q == a")
+		}
+		b.Neg(a)
+		c := new(big.Int).Sub(q, a)
+		if !FrFromBig(b).Equal(FrFromBig(c)) {
+			t.Fatal("-a == q - a")
+		}
+		if !new(Fr).SetUint64(1).IsOne() {
+			t.Fatal("1 == 1")
+		}
+	})
+	t.Run("oversized input", func(t *testing.T) {
+		for _, size := range []int{frByteSize + 1, 64, 96} {
+			in := make([]byte, size)
+			_, _ = rand.Read(in)
+			expected := new(big.Int).Mod(new(big.Int).SetBytes(in), q)
+			if new(Fr).setBytes(in).big().Cmp(expected) != 0 {
+				t.Fatal("oversized input must be reduced", size)
+			}
+		}
+	})
+}
+
+func TestFrArithmeticCrossAgainstBigInt(t *testing.T) {
+	qMinus1 := new(big.Int).Sub(q, big.NewInt(1))
+	vectors := [][2]*big.Int{
+		{qMinus1, qMinus1},
+		{qMinus1, big.NewInt(1)},
+		{big.NewInt(0), qMinus1},
+	}
+	for i := 0; i < fuz; i++ {
+		vectors = append(vectors, [2]*big.Int{randScalar(q), randScalar(q)})
+	}
+	for _, v := range vectors {
+		big_a, big_b, big_c := v[0], v[1], new(big.Int)
+		a, b, c := FrFromBig(big_a), FrFromBig(big_b), new(Fr)
+		c.Add(a, b)
+		if c.ToBig().Cmp(big_c.Add(big_a, big_b).Mod(big_c, q)) != 0 {
+			t.Fatal("cross test against big.Int is failed A")
+		}
+		c.Double(a)
+		if c.ToBig().Cmp(big_c.Add(big_a, big_a).Mod(big_c, q)) != 0 {
+			t.Fatal("cross test against big.Int is failed B")
+		}
+		c.Sub(a, b)
+		if c.ToBig().Cmp(big_c.Sub(big_a, big_b).Mod(big_c, q)) != 0 {
+			t.Fatal("cross test against big.Int is failed C")
+		}
+		c.Neg(a)
+		if c.ToBig().Cmp(big_c.Neg(big_a).Mod(big_c, q)) != 0 {
+			t.Fatal("cross test against big.Int is failed D")
+		}
+		c.Mul(a, b)
+		if c.ToBig().Cmp(big_c.Mul(big_a, big_b).Mod(big_c, q)) != 0 {
+			t.Fatal("cross test against big.Int is failed E")
+		}
+		c.Square(a)
+		if c.ToBig().Cmp(big_c.Mul(big_a, big_a).Mod(big_c, q)) != 0 {
+			t.Fatal("cross test against big.Int is failed F")
+		}
+	}
+}
+
+func TestFrMultiplicationProperties(t *testing.T) {
+	for i := 0; i < fuz; i++ {
+		a, _ := new(Fr).Rand(rand.Reader)
+		b, _ := new(Fr).Rand(rand.Reader)
+		c0, _ := new(Fr).Rand(rand.Reader)
+		zero, one := new(Fr).Zero(), new(Fr).One()
+		c1, c2 := new(Fr), new(Fr)
+		if !c1.Mul(a, zero).Equal(zero) {
+			t.Fatal("a * 0 == 0")
+		}
+		if !c1.Mul(a, one).Equal(a) {
+			t.Fatal("a * 1 == a")
+		}
+		c1.Mul(a, b).Mul(c1, c0)
+		c2.Mul(c0, b).Mul(c2, a)
+		if !c1.Equal(c2) {
+			t.Fatal("(a * b) * c == (a * c) * b")
+		}
+		c0.Square(a)
+		c1.Square(b)
+		c0.Sub(c0, c1)
+		c1.Sub(a, b)
+		c2.Add(a, b)
+		c1.Mul(c1, c2)
+		if !c0.Equal(c1) {
+			t.Fatal("a^2 - b^2 == (a - b)(a + b)")
+		}
+	}
+}
+
+func TestFrExponentiation(t *testing.T) {
+	for i := 0; i < fuz; i++ {
+		a, _ := new(Fr).Rand(rand.Reader)
+		u, v := new(Fr), new(Fr)
+		if !u.Exp(a, big.NewInt(0)).IsOne() {
+			t.Fatal("a^0 == 1")
+		}
+		if !u.Exp(a, big.NewInt(1)).Equal(a) {
+			t.Fatal("a^1 == a")
+		}
+		u.Mul(a, a).Mul(u, u).Mul(u, u)
+		if !u.Equal(v.Exp(a, big.NewInt(8))) {
+			t.Fatal("((a^2)^2)^2 == a^8")
+		}
+		if !u.Exp(a, q).Equal(a) {
+			t.Fatal("a^q == a")
+		}
+		if !u.Exp(a, new(big.Int).Sub(q, big.NewInt(1))).IsOne() {
+			t.Fatal("a^(q-1) == 1")
+		}
+	}
+}
+
+func TestFrInversion(t *testing.T) {
+	u := new(Fr)
+	zero, one := new(Fr).Zero(), new(Fr).One()
+	if !u.Inverse(zero).IsZero() {
+		t.Fatal("(0^-1) == 0)")
+	}
+	if !u.Inverse(one).IsOne() {
+		t.Fatal("(1^-1) == 1)")
+	}
+	for i := 0; i < fuz; i++ {
+		a, _ := new(Fr).Rand(rand.Reader)
+		if !u.Inverse(a).Mul(u, a).IsOne() {
+			t.Fatal("a * a^-1 == 1")
+		}
+		big_u := new(big.Int).ModInverse(a.ToBig(), q)
+		if u.Inverse(a).ToBig().Cmp(big_u) != 0 {
+			t.Fatal("cross test against big.Int is failed")
+		}
+	}
+}
+
+func TestFrSqrt(t *testing.T) {
+	r := new(Fr)
+	if !r.Sqrt(new(Fr).Zero()) || !r.IsZero() {
+		t.Fatal("sqrt(0) == 0")
+	}
+	if !r.Sqrt(new(Fr).One()) || !r.Square(r).IsOne() {
+		t.Fatal("sqrt(1)^2 == 1")
+	}
+	nonResidue := new(Fr).SetUint64(frGenerator)
+	if nonResidue.Legendre() != -1 || r.Sqrt(nonResidue) {
+		t.Fatal("generator must be non residue")
+	}
+	if new(Fr).Legendre() != 0 {
+		t.Fatal("legendre(0) == 0")
+	}
+	// elements with high two-adic order exercises the inner loop of Tonelli-Shanks
+	w := new(Fr).Set(frRootOfUnity)
+	if w.Legendre() != -1 {
+		t.Fatal("primitive root of unity must be non residue")
+	}
+	for i := 0; i < frTwoAdicity-1; i++ {
+		squareFR(w, w)
+		u := new(Fr).Set(w)
+		if u.Legendre() != 1 {
+			t.Fatal("even powers of root of unity must be residues")
+		}
+		if !r.Sqrt(u) || !new(Fr).Square(r).Equal(u) {
+			t.Fatal("sqrt(w)^2 == w")
+		}
+	}
+	for i := 0; i < fuz; i++ {
+		a, _ := new(Fr).Rand(rand.Reader)
+		aa := new(Fr).Square(a)
+		if aa.Legendre() != 1 && !aa.IsZero() {
+			t.Fatal("legendre(a^2) == 1")
+		}
+		if !r.Sqrt(aa) {
+			t.Fatal("square must have square root")
+		}
+		if !r.Equal(a) && !r.Equal(new(Fr).Neg(a)) {
+			t.Fatal("sqrt(a^2) == a or -a")
+		}
+		u := new(Fr).Mul(aa, nonResidue)
+		if u.Legendre() != -1 {
+			t.Fatal("legendre(a^2 * n) == -1")
+		}
+		r.Set(a)
+		if r.Sqrt(u) || !r.Equal(a) {
+			t.Fatal("non residue must not have square root")
+		}
+		big_l := big.Jacobi(a.ToBig(), q)
+		if a.Legendre() != big_l {
+			t.Fatal("cross test against big.Int is failed")
+		}
+	}
+}
+
+func BenchmarkFrSqrt(t *testing.B) {
+	a, _ := new(Fr).Rand(rand.Reader)
+	a.Square(a)
+	c := new(Fr)
+	t.ResetTimer()
+	for i := 0; i < t.N; i++ {
+		sqrtFR(c, a)
+	}
+}
+
+func BenchmarkFrMul(t *testing.B) {
+	a, _ := new(Fr).Rand(rand.Reader)
+	b, _ := new(Fr).Rand(rand.Reader)
+	c := new(Fr)
+	t.ResetTimer()
+	for i := 0; i < t.N; i++ {
+		mulFR(c, a, b)
+	}
+	_ = c
+}
+
+func BenchmarkFrInv(t *testing.B) {
+	a, _ := new(Fr).Rand(rand.Reader)
+	c := new(Fr)
+	t.ResetTimer()
+	for i := 0; i < t.N; i++ {
+		inverseFR(c, a)
+	}
+	_ = c
+}
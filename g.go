@@ -2,35 +2,32 @@ package bw6
 
 import (
 	"errors"
-	"math"
+	"fmt"
 	"math/big"
 )
 
-// Point is type for point in G and used for both affine and Jacobian representation.
+// point is the common representation of G1 and G2 points and used for both affine and Jacobian representation.
 // A point is accounted as in affine form if z is equal to one.
-type Point [3]fe
+type point [3]fe
 
 var wnafMulWindow uint = 6
 var glvMulWindow uint = 4
 
-// Set sets the point p2 to p
-func (p *Point) Set(p2 *Point) *Point {
+func (p *point) set(p2 *point) *point {
 	p[0].set(&p2[0])
 	p[1].set(&p2[1])
 	p[2].set(&p2[2])
 	return p
 }
 
-// Zero sets point p as point at infinity
-func (p *Point) Zero() *Point {
+func (p *point) zero() *point {
 	p[0].zero()
 	p[1].one()
 	p[2].zero()
 	return p
 }
 
-// IsAffine checks a G point whether it is in affine form.
-func (p *Point) IsAffine() bool {
+func (p *point) isAffine() bool {
 	return p[2].isOne()
 }
 
@@ -38,17 +35,6 @@ type tempG struct {
 	t [9]*fe
 }
 
-// G is struct for group.
-type G struct {
-	tempG
-}
-
-// NewG constructs a new G instance.
-func NewG() *G {
-	t := newTempG()
-	return &G{t}
-}
-
 func newTempG() tempG {
 	t := [9]*fe{}
 	for i := 0; i < 9; i++ {
@@ -57,43 +43,61 @@ func newTempG() tempG {
 	return tempG{t}
 }
 
-// Q returns group order in big.Int.
-func (g *G) Q() *big.Int {
-	return new(big.Int).Set(q)
+// group implements arithmetic shared by G1 and G2.
+// Both groups are defined over base field with curves in form of y^2 = x^3 + b
+// and only differ in curve constant, generator, endomorphism and cofactor.
+type group struct {
+	tempG
+	b        *fe
+	glvPhi   *fe
+	cofactor *big.Int
 }
 
-// G2FromBytes constructs a new point given uncompressed byte input.
-// Input string is expected to be equal to 192 bytes and concatenation of x and y cooridanates.
-// (0, 0) is considered as infinity.
-func (g *G) G1FromBytes(in []byte) (*Point, error) {
-	p, err := g.fromBytes(in)
-	if err != nil {
-		return nil, err
-	}
-	if !g.IsOnG1Curve(p) {
-		return nil, errors.New("point is not on curve")
-	}
-	return p, nil
+func newGroup(b *fe, glvPhi *fe, cofactor *big.Int) group {
+	return group{newTempG(), b, glvPhi, cofactor}
 }
 
-// G2FromBytes constructs a new point given uncompressed byte input.
-// Input string is expected to be equal to 192 bytes and concatenation of x and y cooridanates.
-// (0, 0) is considered as infinity.
-func (g *G) G2FromBytes(in []byte) (*Point, error) {
-	p, err := g.fromBytes(in)
-	if err != nil {
-		return nil, err
+// Serialization flags are placed in the spare top bits of the first byte
+// since base field elements fit in 761 bits.
+const (
+	serializationCompressed = 1 << 7
+	serializationInfinity   = 1 << 6
+	serializationBigY       = 1 << 5
+	serializationMask       = serializationCompressed | serializationInfinity | serializationBigY
+)
+
+// fromBytes decodes a point either in compressed or uncompressed form
+// which is determined by the compression flag.
+func (g *group) fromBytes(in []byte) (*point, error) {
+	if len(in) == 0 {
+		return nil, errors.New("input string length must be equal to 96 or 192 bytes")
 	}
-	if !g.IsOnG2Curve(p) {
-		return nil, errors.New("point is not on curve")
+	if in[0]&serializationCompressed != 0 {
+		return g.fromCompressed(in)
 	}
-	return p, nil
+	return g.fromUncompressed(in)
 }
 
-func (g *G) fromBytes(in []byte) (*Point, error) {
+func (g *group) fromUncompressed(in []byte) (*point, error) {
 	if len(in) != 2*fpByteSize {
 		return nil, errors.New("input string length must be equal to 192 bytes")
 	}
+	if in[0]&serializationCompressed != 0 {
+		return nil, errors.New("compression flag must not be set")
+	}
+	if in[0]&serializationBigY != 0 {
+		return nil, errors.New("sign flag must not be set")
+	}
+	if in[0]&serializationInfinity != 0 {
+		if !isInfinityEncoding(in) {
+			return nil, errors.New("invalid infinity encoding")
+		}
+		return new(point).zero(), nil
+	}
+	// all zero input is the encoding of infinity prior to flag bits and is still accepted
+	if isInfinityEncoding(in) {
+		return new(point).zero(), nil
+	}
 	x, err := fromBytes(in[:fpByteSize])
 	if err != nil {
 		return nil, err
@@ -102,61 +106,101 @@ func (g *G) fromBytes(in []byte) (*Point, error) {
 	if err != nil {
 		return nil, err
 	}
-	// check if given input points to infinity
-	if x.isZero() && y.isZero() {
-		return g.Zero(), nil
+	z := new(fe).one()
+	p := &point{*x, *y, *z}
+	if !g.isOnCurve(p) {
+		return nil, errors.New("point is not on curve")
+	}
+	return p, nil
+}
+
+func (g *group) fromCompressed(in []byte) (*point, error) {
+	if len(in) != fpByteSize {
+		return nil, errors.New("input string length must be equal to 96 bytes")
+	}
+	if in[0]&serializationCompressed == 0 {
+		return nil, errors.New("compression flag must be set")
+	}
+	if in[0]&serializationInfinity != 0 {
+		if !isInfinityEncoding(in) {
+			return nil, errors.New("invalid infinity encoding")
+		}
+		return new(point).zero(), nil
+	}
+	bigY := in[0]&serializationBigY != 0
+	buf := make([]byte, fpByteSize)
+	copy(buf, in)
+	buf[0] &= ^byte(serializationMask)
+	x, err := fromBytes(buf)
+	if err != nil {
+		return nil, err
+	}
+	y := new(fe)
+	square(y, x)
+	mul(y, y, x)
+	addAssign(y, g.b)
+	if !sqrt(y, y) {
+		return nil, errors.New("point is not on curve")
+	}
+	if y.isZero() && bigY {
+		return nil, errors.New("sign flag must not be set for zero y coordinate")
+	}
+	if y.signBE() == bigY {
+		neg(y, y)
 	}
 	z := new(fe).one()
-	return &Point{*x, *y, *z}, nil
+	return &point{*x, *y, *z}, nil
 }
 
-// ToBytes serializes a point into bytes in uncompressed form.
-// It returns (0, 0) if point is infinity.
-func (g *G) ToBytes(p *Point) []byte {
+func isInfinityEncoding(in []byte) bool {
+	if in[0]&^byte(serializationCompressed|serializationInfinity) != 0 {
+		return false
+	}
+	for i := 1; i < len(in); i++ {
+		if in[i] != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (g *group) toBytes(p *point) []byte {
 	out := make([]byte, 2*fpByteSize)
-	if g.IsZero(p) {
+	if g.isZero(p) {
+		out[0] |= serializationInfinity
 		return out
 	}
-	g.Affine(p)
+	g.affine(p, p)
 	copy(out[:fpByteSize], toBytes(&p[0]))
 	copy(out[fpByteSize:], toBytes(&p[1]))
 	return out
 }
 
-// New creates a new G Point which is equal to zero in other words point at infinity.
-func (g *G) New() *Point {
-	return g.Zero()
-}
-
-// Zero returns a new G Point which is equal to point at infinity.
-func (g *G) Zero() *Point {
-	return new(Point).Zero()
-}
-
-// G1One returns a new G1 generator.
-func (g *G) G1One() *Point {
-	p := &Point{}
-	return p.Set(&g1One)
-}
-
-// G1One returns a new G1 generator.
-func (g *G) G2One() *Point {
-	p := &Point{}
-	return p.Set(&g2One)
+func (g *group) toCompressed(p *point) []byte {
+	out := make([]byte, fpByteSize)
+	if g.isZero(p) {
+		out[0] |= serializationCompressed | serializationInfinity
+		return out
+	}
+	g.affine(p, p)
+	copy(out, toBytes(&p[0]))
+	out[0] |= serializationCompressed
+	if !p[1].signBE() {
+		out[0] |= serializationBigY
+	}
+	return out
 }
 
-// IsZero returns true if given point is equal to zero.
-func (g *G) IsZero(p *Point) bool {
+func (g *group) isZero(p *point) bool {
 	return p[2].isZero()
 }
 
-// Equal checks if given two G point is equal in their affine form.
-func (g *G) Equal(p1, p2 *Point) bool {
-	if g.IsZero(p1) {
-		return g.IsZero(p2)
+func (g *group) equal(p1, p2 *point) bool {
+	if g.isZero(p1) {
+		return g.isZero(p2)
 	}
-	if g.IsZero(p2) {
-		return g.IsZero(p1)
+	if g.isZero(p2) {
+		return g.isZero(p1)
 	}
 	t := g.t
 	square(t[0], &p1[2])
@@ -170,65 +214,31 @@ func (g *G) Equal(p1, p2 *Point) bool {
 	return t[0].equal(t[1]) && t[2].equal(t[3])
 }
 
-// IsOnG1Curve checks if G1 point is on curve.
-func (g *G) IsOnG1Curve(p *Point) bool {
-	if g.IsZero(p) {
+func (g *group) isOnCurve(p *point) bool {
+	if g.isZero(p) {
 		return true
 	}
 	t := g.t
 	square(t[0], &p[1])    // y^2
 	square(t[1], &p[0])    // x^2
 	mul(t[1], t[1], &p[0]) // x^3
-	if p.IsAffine() {
-		addAssign(t[1], b)      // x^2 + b
+	if p.isAffine() {
+		addAssign(t[1], g.b)    // x^3 + b
 		return t[0].equal(t[1]) // y^2 ?= x^3 + b
 	}
 	square(t[2], &p[2])     // z^2
 	square(t[3], t[2])      // z^4
-	mul(t[2], t[2], t[3])   // -b*z^6
-	subAssign(t[1], t[2])   // x^3 + b * z^6
-	return t[0].equal(t[1]) // y^2 ?= x^3 + b * z^6
-}
-
-// IsOnG1Curve checks if G1 point is on curve.
-func (g *G) IsOnG2Curve(p *Point) bool {
-	if g.IsZero(p) {
-		return true
-	}
-	t := g.t
-	square(t[0], &p[1])    // y^2
-	square(t[1], &p[0])    // x^2
-	mul(t[1], t[1], &p[0]) // x^3
-	if p.IsAffine() {
-		addAssign(t[1], b2)     // x^2 + b
-		return t[0].equal(t[1]) // y^2 ?= x^3 + b
-	}
-	square(t[2], &p[2])   // z^2
-	square(t[3], t[2])    // z^4
-	mul(t[2], t[2], t[3]) // z^6
-
-	doubleAssign(t[2])
-	doubleAssign(t[2]) // b * z^6
-
+	mul(t[2], t[2], t[3])   // z^6
+	mul(t[2], t[2], g.b)    // b * z^6
 	addAssign(t[1], t[2])   // x^3 + b * z^6
 	return t[0].equal(t[1]) // y^2 ?= x^3 + b * z^6
 }
 
-// IsAffine checks a G point whether it is in affine form.
-func (g *G) IsAffine(p *Point) bool {
-	return p[2].isOne()
-}
-
-// Affine returns the affine representation of the given point
-func (g *G) Affine(p *Point) *Point {
-	return g.affine(p, p)
-}
-
-func (g *G) affine(r, p *Point) *Point {
-	if g.IsZero(p) {
-		return r.Zero()
+func (g *group) affine(r, p *point) *point {
+	if g.isZero(p) {
+		return r.zero()
 	}
-	if !g.IsAffine(p) {
+	if !p.isAffine() {
 		t := g.t
 		inverse(t[0], &p[2])    // z^-1
 		square(t[1], t[0])      // z^-2
@@ -237,13 +247,12 @@ func (g *G) affine(r, p *Point) *Point {
 		mul(&r[1], &p[1], t[0]) // y = y * z^-3
 		r[2].one()              // z = 1
 	} else {
-		r.Set(p)
+		r.set(p)
 	}
 	return r
 }
 
-// AffineBatch given multiple of points returns affine representations
-func (g *G) AffineBatch(p []*Point) {
+func (g *group) affineBatch(p []*point) {
 	inverses := make([]fe, len(p))
 	for i := 0; i < len(p); i++ {
 		inverses[i].set(&p[i][2])
@@ -251,7 +260,7 @@ func (g *G) AffineBatch(p []*Point) {
 	inverseBatch(inverses)
 	t := g.t
 	for i := 0; i < len(p); i++ {
-		if !g.IsAffine(p[i]) && !g.IsZero(p[i]) {
+		if !p[i].isAffine() && !g.isZero(p[i]) {
 			square(t[1], &inverses[i])
 			mul(&p[i][0], &p[i][0], t[1])
 			mul(t[0], &inverses[i], t[1])
@@ -261,14 +270,13 @@ func (g *G) AffineBatch(p []*Point) {
 	}
 }
 
-// Add adds two G points p1, p2 and assigns the result to point at first argument.
-func (g *G) Add(r, p1, p2 *Point) *Point {
+func (g *group) add(r, p1, p2 *point) *point {
 	// http://www.hyperelliptic.org/EFD/gp/auto-shortw-jacobian-0.html#addition-add-2007-bl
-	if g.IsZero(p1) {
-		return r.Set(p2)
+	if g.isZero(p1) {
+		return r.set(p2)
 	}
-	if g.IsZero(p2) {
-		return r.Set(p1)
+	if g.isZero(p2) {
+		return r.set(p1)
 	}
 	t := g.t
 	square(t[7], &p1[2])    // z1z1
@@ -281,9 +289,9 @@ func (g *G) Add(r, p1, p2 *Point) *Point {
 	mul(t[2], &p1[1], t[4]) // s1 = y1 * z2z2 * z2
 	if t[1].equal(t[3]) {
 		if t[0].equal(t[2]) {
-			return g.Double(r, p1)
+			return g.double(r, p1)
 		} else {
-			return r.Zero()
+			return r.zero()
 		}
 	}
 	subAssign(t[1], t[3])      // h = u2 - u1
@@ -310,15 +318,13 @@ func (g *G) Add(r, p1, p2 *Point) *Point {
 	return r
 }
 
-// Add adds two G points p1, p2 and assigns the result to point at first argument.
-// Expects the second point p2 in affine form.
-func (g *G) AddMixed(r, p1, p2 *Point) *Point {
+func (g *group) addMixed(r, p1, p2 *point) *point {
 	// http://www.hyperelliptic.org/EFD/Gp/auto-shortw-jacobian-0.html#addition-madd-2007-bl
-	if g.IsZero(p1) {
-		return r.Set(p2)
+	if g.isZero(p1) {
+		return r.set(p2)
 	}
-	if g.IsZero(p2) {
-		return r.Set(p1)
+	if g.isZero(p2) {
+		return r.set(p1)
 	}
 	t := g.t
 	square(t[7], &p1[2])    // z1z1
@@ -327,7 +333,7 @@ func (g *G) AddMixed(r, p1, p2 *Point) *Point {
 	mul(t[0], &p2[1], t[2]) // s2 = y2 * z1z1 * z1
 
 	if p1[0].equal(t[1]) && p1[1].equal(t[0]) {
-		return g.Double(r, p1)
+		return g.double(r, p1)
 	}
 
 	sub(t[1], t[1], &p1[0]) // h = u2 - x1
@@ -354,11 +360,10 @@ func (g *G) AddMixed(r, p1, p2 *Point) *Point {
 	return r
 }
 
-// Double doubles a G point p and assigns the result to the point at first argument.
-func (g *G) Double(r, p *Point) *Point {
+func (g *group) double(r, p *point) *point {
 	// http://www.hyperelliptic.org/EFD/gp/auto-shortw-jacobian-0.html#doubling-dbl-2009-l
-	if g.IsZero(p) {
-		return r.Set(p)
+	if g.isZero(p) {
+		return r.set(p)
 	}
 	t := g.t
 	square(t[0], &p[0])     // a = x^2
@@ -386,139 +391,141 @@ func (g *G) Double(r, p *Point) *Point {
 	return r
 }
 
-// Sub subtracts two G points p1, p2 and assigns the result to point at first argument.
-func (g *G) Sub(c, a, b *Point) *Point {
-	d := &Point{}
-	g.Neg(d, b)
-	g.Add(c, a, d)
+func (g *group) sub(c, a, b *point) *point {
+	d := &point{}
+	g.neg(d, b)
+	g.add(c, a, d)
 	return c
 }
 
-// Neg negates a G point p and assigns the result to the point at first argument.
-func (g *G) Neg(r, p *Point) *Point {
+func (g *group) neg(r, p *point) *point {
 	r[0].set(&p[0])
 	r[2].set(&p[2])
 	neg(&r[1], &p[1])
 	return r
 }
 
-// MulScalar multiplies a G1 point by given scalar value in big.Int and assigns the result to point at first argument.
-func (g *G) MulScalarG1(r, p *Point, e *big.Int) *Point {
-	return g.glvMulG1(r, p, e)
-}
-
-// MulScalarG2 multiplies a G2 point by given scalar value in big.Int and assigns the result to point at first argument.
-func (g *G) MulScalarG2(r, p *Point, e *big.Int) *Point {
-	return g.glvMulG2(r, p, e)
-}
-
-func (g *G) mulScalar(r, p *Point, e *big.Int) *Point {
-	q, n := &Point{}, &Point{}
-	n.Set(p)
-	l := e.BitLen()
+// mulScalar is the naive double and add multiplication. Scalar is not reduced so that
+// it can be used for points out of correct subgroup. Negative scalars are supported.
+func (g *group) mulScalar(r, p *point, e *big.Int) *point {
+	q, n := new(point).zero(), &point{}
+	n.set(p)
+	if e.Sign() < 0 {
+		g.neg(n, n)
+	}
+	k := new(big.Int).Abs(e)
+	l := k.BitLen()
 	for i := 0; i < l; i++ {
-		if e.Bit(i) == 1 {
-			g.Add(q, q, n)
+		if k.Bit(i) == 1 {
+			g.add(q, q, n)
 		}
-		g.Double(n, n)
+		g.double(n, n)
 	}
-	return r.Set(q)
+	return r.set(q)
 }
 
-func (g *G) wnafMul(r, p *Point, e *big.Int) *Point {
+// wnafMul multiplies with window non adjacent form. Like mulScalar scalar is not reduced
+// and negative scalars are supported.
+func (g *group) wnafMul(r, p *point, e *big.Int) *point {
 	wnaf := bigToWNAF(e, wnafMulWindow)
 	return g._wnafMul(r, p, wnaf)
 }
 
-func (g *G) _wnafMul(r, p *Point, wnaf nafNumber) *Point {
+func (g *group) _wnafMul(r, p *point, wnaf nafNumber) *point {
 
 	l := (1 << (wnafMulWindow - 1))
 
-	twoP, acc := g.New(), new(Point).Set(p)
-	g.Double(twoP, p)
-	g.Affine(twoP)
+	twoP, acc := new(point).zero(), new(point).set(p)
+	g.double(twoP, p)
+	g.affine(twoP, twoP)
 
 	// table = {p, 3p, 5p, ..., -p, -3p, -5p}
-	table := make([]*Point, l*2)
-	table[0], table[l] = g.New(), g.New()
-	table[0].Set(p)
-	g.Neg(table[l], table[0])
+	table := make([]*point, l*2)
+	table[0], table[l] = new(point).zero(), new(point).zero()
+	table[0].set(p)
+	g.neg(table[l], table[0])
 
 	for i := 1; i < l; i++ {
-		g.AddMixed(acc, acc, twoP)
-		table[i], table[i+l] = g.New(), g.New()
-		table[i].Set(acc)
-		g.Neg(table[i+l], table[i])
+		g.addMixed(acc, acc, twoP)
+		table[i], table[i+l] = new(point).zero(), new(point).zero()
+		table[i].set(acc)
+		g.neg(table[i+l], table[i])
 	}
 
-	q := g.Zero()
+	q := new(point).zero()
 	for i := len(wnaf) - 1; i >= 0; i-- {
 		if wnaf[i] > 0 {
-			g.Add(q, q, table[wnaf[i]>>1])
+			g.add(q, q, table[wnaf[i]>>1])
 		} else if wnaf[i] < 0 {
-			g.Add(q, q, table[((-wnaf[i])>>1)+l])
+			g.add(q, q, table[((-wnaf[i])>>1)+l])
 		}
 		if i != 0 {
-			g.Double(q, q)
+			g.double(q, q)
 		}
 	}
-	return r.Set(q)
+	return r.set(q)
 }
 
-func (g *G) glvMulG1(r, p *Point, e *big.Int) *Point {
-	return g.glvMul(r, p, new(glvVector).new(e), g.glvEndomorphismG1)
+// glvMul expects the point in correct subgroup where the scalar is reduced modulo q.
+// Negative scalars and scalars not less than q are supported.
+func (g *group) glvMul(r, p0 *point, e *big.Int) *point {
+	v := new(glvVector).new(new(big.Int).Mod(e, q))
+	naf1, naf2 := v.wnaf(glvMulWindow)
+	return g.glvMulNAF(r, p0, naf1, naf2)
 }
 
-func (g *G) glvMulG2(r, p *Point, e *big.Int) *Point {
-	return g.glvMul(r, p, new(glvVector).new(e), g.glvEndomorphismG2)
+// glvMulFr is glvMul where the scalar is decomposed on limbs of the scalar field element.
+func (g *group) glvMulFr(r, p0 *point, e *Fr) *point {
+	k := new(Fr)
+	fromMontFR(k, e)
+	naf1, naf2 := new(glvVectorFr).new(k).wnaf(glvMulWindow)
+	return g.glvMulNAF(r, p0, naf1, naf2)
 }
 
-func (g *G) glvMul(r, p0 *Point, v *glvVector, endoFn func(r, p *Point)) *Point {
-
+// glvMulNAF calculates k1 * P + k2 * φ(P) given window non adjacent forms of k1 and k2.
+func (g *group) glvMulNAF(r, p0 *point, naf1, naf2 nafNumber) *point {
 	w := glvMulWindow
 	l := 1 << (w - 1)
 
 	// prepare tables
 	// tableK1 = {P, 3P, 5P, ...}
 	// tableK2 = {λP, 3λP, 5λP, ...}
-	tableK1, tableK2 := make([]*Point, l), make([]*Point, l)
-	double := g.New()
-	g.Double(double, p0)
+	tableK1, tableK2 := make([]*point, l), make([]*point, l)
+	double := new(point).zero()
+	g.double(double, p0)
 	g.affine(double, double)
-	tableK1[0] = new(Point)
-	tableK1[0].Set(p0)
+	tableK1[0] = new(point)
+	tableK1[0].set(p0)
 	for i := 1; i < l; i++ {
-		tableK1[i] = new(Point)
-		g.AddMixed(tableK1[i], tableK1[i-1], double)
+		tableK1[i] = new(point)
+		g.addMixed(tableK1[i], tableK1[i-1], double)
 	}
-	g.AffineBatch(tableK1)
+	g.affineBatch(tableK1)
 	for i := 0; i < l; i++ {
-		tableK2[i] = new(Point)
-		endoFn(tableK2[i], tableK1[i])
+		tableK2[i] = new(point)
+		g.glvEndomorphism(tableK2[i], tableK1[i])
 	}
 
-	// recode small scalars
-	naf1, naf2 := v.wnaf(w)
 	lenNAF1, lenNAF2 := len(naf1), len(naf2)
 	lenNAF := lenNAF1
 	if lenNAF2 > lenNAF {
 		lenNAF = lenNAF2
 	}
 
-	acc, p1 := g.New(), g.New()
+	acc, p1 := new(point).zero(), new(point).zero()
 
 	// function for naf addition
-	add := func(table []*Point, naf int) {
+	add := func(table []*point, naf int) {
 		if naf != 0 {
 			nafAbs := naf
 			if nafAbs < 0 {
 				nafAbs = -nafAbs
 			}
-			p1.Set(table[nafAbs>>1])
+			p1.set(table[nafAbs>>1])
 			if naf < 0 {
-				g.Neg(p1, p1)
+				g.neg(p1, p1)
 			}
-			g.AddMixed(acc, acc, p1)
+			g.addMixed(acc, acc, p1)
 		}
 	}
 
@@ -531,73 +538,136 @@ func (g *G) glvMul(r, p0 *Point, v *glvVector, endoFn func(r, p *Point)) *Point
 			add(tableK2, naf2[i])
 		}
 		if i != 0 {
-			g.Double(acc, acc)
+			g.double(acc, acc)
+		}
+	}
+	return r.set(acc)
+}
+
+var (
+	// ErrLengthMismatch is returned when point and scalar vectors of a multi exponentiation differ in length.
+	ErrLengthMismatch = errors.New("point and scalar vectors should be in same length")
+	// ErrNilPoint is returned when a point of a multi exponentiation is nil.
+	ErrNilPoint = errors.New("point must not be nil")
+	// ErrNilScalar is returned when a scalar of a multi exponentiation is nil.
+	ErrNilScalar = errors.New("scalar must not be nil")
+)
+
+// checkMultiExpInput returns an error wrapping one of ErrLengthMismatch, ErrNilPoint
+// and ErrNilScalar with the index of the first nil entry.
+func checkMultiExpInput(points []*point, n int, isNilScalar func(i int) bool) error {
+	if len(points) != n {
+		return ErrLengthMismatch
+	}
+	for i := 0; i < n; i++ {
+		if points[i] == nil {
+			return fmt.Errorf("%w at index %d", ErrNilPoint, i)
+		}
+		if isNilScalar(i) {
+			return fmt.Errorf("%w at index %d", ErrNilScalar, i)
 		}
 	}
-	return r.Set(acc)
+	return nil
 }
 
-// MultiExp calculates multi exponentiation. Given pairs of G point and scalar values
-// (P_0, e_0), (P_1, e_1), ... (P_n, e_n) calculates r = e_0 * P_0 + e_1 * P_1 + ... + e_n * P_n
-// Length of points and scalars are expected to be equal, otherwise an error is returned.
-// Result is assigned to point at first argument.
-func (g *G) MultiExp(r *Point, points []*Point, scalars []*big.Int) (*Point, error) {
-	if len(points) != len(scalars) {
-		return nil, errors.New("point and scalar vectors should be in same length")
+// multiExpBig reduces scalars modulo q so that negative scalars and scalars not less than q are supported.
+func (g *group) multiExpBig(r *point, points []*point, scalars []*big.Int) (*point, error) {
+	if err := checkMultiExpInput(points, len(scalars), func(i int) bool { return scalars[i] == nil }); err != nil {
+		return nil, err
 	}
+	s := make([]Fr, len(scalars))
+	for i := 0; i < len(scalars); i++ {
+		s[i].Set(frFromBigReduced(scalars[i]))
+	}
+	return g.multiExp(r, points, s), nil
+}
+
+func (g *group) multiExpFr(r *point, points []*point, scalars []*Fr) (*point, error) {
+	return g.multiExpFrParallel(r, points, scalars, 1, false)
+}
 
-	c := 3
-	if len(scalars) >= 32 {
-		c = int(math.Ceil(math.Log(float64(len(scalars)))))
+func (g *group) multiExpFrParallel(r *point, points []*point, scalars []*Fr, workers int, glv bool) (*point, error) {
+	if err := checkMultiExpInput(points, len(scalars), func(i int) bool { return scalars[i] == nil }); err != nil {
+		return nil, err
+	}
+	s := make([]Fr, len(scalars))
+	for i := 0; i < len(scalars); i++ {
+		fromMontFR(&s[i], scalars[i])
 	}
+	return g.multiExpParallel(r, points, s, workers, glv), nil
+}
 
-	bucketSize := (1 << c) - 1
-	windows := make([]Point, frBitSize/c+1)
-	bucket := make([]Point, bucketSize)
-	for j := 0; j < len(windows); j++ {
+// mulX multiplies a point by the curve parameter x.
+func (g *group) mulX(r, p *point) *point {
+	return g.mulParam(r, p, x)
+}
 
-		for i := 0; i < bucketSize; i++ {
-			bucket[i].Zero()
+// mulParam multiplies a point by a small positive parameter with double and add.
+func (g *group) mulParam(r, p *point, e *big.Int) *point {
+	acc := new(point).set(p)
+	for i := e.BitLen() - 2; i >= 0; i-- {
+		g.double(acc, acc)
+		if e.Bit(i) == 1 {
+			g.add(acc, acc, p)
 		}
+	}
+	return r.set(acc)
+}
 
-		for i := 0; i < len(scalars); i++ {
-			index := bucketSize & int(new(big.Int).Rsh(scalars[i], uint(c*j)).Int64())
-			if index != 0 {
-				g.Add(&bucket[index-1], &bucket[index-1], points[i])
+// mulEndoPoly computes h1(x)P + h2(x)φ(P) where h1 and h2 are polynomials in the curve parameter x
+// with small coefficients given in ascending degree order and φ is the GLV endomorphism.
+// h1 and h2 are expected to be in same length.
+func (g *group) mulEndoPoly(r, p *point, h1, h2 []int64) *point {
+	// powers 2^j * P up to the largest coefficient are computed once and shared by h1 and h2
+	// where c * φ(P) = φ(c * P)
+	var max int64
+	for i := 0; i < len(h1); i++ {
+		for _, c := range []int64{h1[i], -h1[i], h2[i], -h2[i]} {
+			if c > max {
+				max = c
 			}
 		}
-
-		acc, sum := g.New(), g.New()
-		for i := bucketSize - 1; i >= 0; i-- {
-			g.Add(sum, sum, &bucket[i])
-			g.Add(acc, acc, sum)
+	}
+	powers := []point{*new(point).set(p)}
+	for int64(1)<<uint(len(powers)) <= max {
+		powers = append(powers, point{})
+		g.double(&powers[len(powers)-1], &powers[len(powers)-2])
+	}
+	multiple := func(r *point, c int64) *point {
+		r.zero()
+		k := c
+		if k < 0 {
+			k = -k
 		}
-		windows[j].Set(acc)
+		for j := 0; k != 0; j, k = j+1, k>>1 {
+			if k&1 == 1 {
+				g.add(r, r, &powers[j])
+			}
+		}
+		if c < 0 {
+			g.neg(r, r)
+		}
+		return r
 	}
-
-	acc := g.New()
-	for i := len(windows) - 1; i >= 0; i-- {
-		for j := 0; j < c; j++ {
-			g.Double(acc, acc)
+	acc, t := new(point).zero(), new(point)
+	for i := len(h1) - 1; i >= 0; i-- {
+		if i != len(h1)-1 {
+			g.mulX(acc, acc)
 		}
-		g.Add(acc, acc, &windows[i])
+		g.add(acc, acc, multiple(t, h1[i]))
+		multiple(t, h2[i])
+		mul(&t[0], &t[0], g.glvPhi)
+		g.add(acc, acc, t)
 	}
-	return r.Set(acc), nil
-}
-
-// ClearG1Cofactor maps given a G1 point to correct subgroup
-func (g *G) ClearG1Cofactor(p *Point) *Point {
-	return g.wnafMul(p, p, cofactorG1)
+	return r.set(acc)
 }
 
-// ClearG2Cofactor maps given a G1 point to correct subgroup
-func (g *G) ClearG2Cofactor(p *Point) *Point {
-	return g.wnafMul(p, p, cofactorG2)
+func (g *group) clearCofactor(p *point) *point {
+	return g.wnafMul(p, p, g.cofactor)
 }
 
-// InCorrectSubgroup checks whether given point is in correct subgroup.
-func (g *G) InCorrectSubgroup(p *Point) bool {
-	tmp := &Point{}
+func (g *group) inCorrectSubgroup(p *point) bool {
+	tmp := &point{}
 	g.wnafMul(tmp, p, q)
-	return g.IsZero(tmp)
+	return g.isZero(tmp)
 }
@@ -0,0 +1,302 @@
+package bw6
+
+import (
+	"math/big"
+)
+
+// coefficients of h1 and h2 in ascending degree order for cofactor clearing in G1
+var clearCofactorG1H1 = []int64{136, -40, -83, 103}
+var clearCofactorG1H2 = []int64{130, 89, 7, 0}
+
+// Suite IDs of hashing to G1 that are expected to be included in domain separation tags.
+const (
+	HashToCurveSuiteG1   = "BW6761G1_XMD:SHA-256_SVDW_RO_"
+	EncodeToCurveSuiteG1 = "BW6761G1_XMD:SHA-256_SVDW_NU_"
+)
+
+// PointG1 is type for point in G1 and used for both affine and Jacobian representation.
+// A point is accounted as in affine form if z is equal to one.
+type PointG1 [3]fe
+
+// Set sets the point p2 to p
+func (p *PointG1) Set(p2 *PointG1) *PointG1 {
+	(*point)(p).set((*point)(p2))
+	return p
+}
+
+// Zero sets point p as point at infinity
+func (p *PointG1) Zero() *PointG1 {
+	(*point)(p).zero()
+	return p
+}
+
+// IsAffine checks a G1 point whether it is in affine form.
+func (p *PointG1) IsAffine() bool {
+	return p[2].isOne()
+}
+
+// G1 is struct for G1 group.
+type G1 struct {
+	group
+}
+
+// NewG1 constructs a new G1 instance.
+func NewG1() *G1 {
+	return &G1{newGroup(b, glvPhi1, cofactorG1)}
+}
+
+// Q returns group order in big.Int.
+func (g *G1) Q() *big.Int {
+	return new(big.Int).Set(q)
+}
+
+// FromBytes constructs a new point given either compressed or uncompressed byte input.
+// Encoding is determined by the compression flag which is the most significant bit of the input.
+// Uncompressed input is expected to be equal to 192 bytes and concatenation of x and y cooridanates.
+// Compressed input is expected to be equal to 96 bytes and x coordinate with sign of y in the flag bits.
+// Non canonical encodings are rejected except all zero uncompressed input which is the encoding
+// of infinity prior to flag bits and is still accepted.
+func (g *G1) FromBytes(in []byte) (*PointG1, error) {
+	p, err := g.fromBytes(in)
+	if err != nil {
+		return nil, err
+	}
+	return (*PointG1)(p), nil
+}
+
+// FromCompressed constructs a new point given compressed byte input.
+// Input string is expected to be equal to 96 bytes.
+func (g *G1) FromCompressed(in []byte) (*PointG1, error) {
+	p, err := g.fromCompressed(in)
+	if err != nil {
+		return nil, err
+	}
+	return (*PointG1)(p), nil
+}
+
+// ToBytes serializes a point into bytes in uncompressed form.
+// Infinity is encoded with infinity flag and remaining bits set to zero.
+func (g *G1) ToBytes(p *PointG1) []byte {
+	return g.toBytes((*point)(p))
+}
+
+// ToCompressed serializes a point into bytes in compressed form.
+// Compression flag is set, sign flag is set if y is lexicographically largest one.
+func (g *G1) ToCompressed(p *PointG1) []byte {
+	return g.toCompressed((*point)(p))
+}
+
+// New creates a new G1 Point which is equal to zero in other words point at infinity.
+func (g *G1) New() *PointG1 {
+	return g.Zero()
+}
+
+// Zero returns a new G1 Point which is equal to point at infinity.
+func (g *G1) Zero() *PointG1 {
+	return new(PointG1).Zero()
+}
+
+// One returns a new G1 generator.
+func (g *G1) One() *PointG1 {
+	return new(PointG1).Set(&g1One)
+}
+
+// IsZero returns true if given point is equal to zero.
+func (g *G1) IsZero(p *PointG1) bool {
+	return g.isZero((*point)(p))
+}
+
+// Equal checks if given two G1 point is equal in their affine form.
+func (g *G1) Equal(p1, p2 *PointG1) bool {
+	return g.equal((*point)(p1), (*point)(p2))
+}
+
+// IsOnCurve checks if G1 point is on curve.
+func (g *G1) IsOnCurve(p *PointG1) bool {
+	return g.isOnCurve((*point)(p))
+}
+
+// IsAffine checks a G1 point whether it is in affine form.
+func (g *G1) IsAffine(p *PointG1) bool {
+	return p.IsAffine()
+}
+
+// Affine returns the affine representation of the given point
+func (g *G1) Affine(p *PointG1) *PointG1 {
+	g.affine((*point)(p), (*point)(p))
+	return p
+}
+
+// AffineBatch given multiple of points returns affine representations
+func (g *G1) AffineBatch(p []*PointG1) {
+	g.affineBatch(pointsG1(p))
+}
+
+// Add adds two G1 points p1, p2 and assigns the result to point at first argument.
+func (g *G1) Add(r, p1, p2 *PointG1) *PointG1 {
+	g.add((*point)(r), (*point)(p1), (*point)(p2))
+	return r
+}
+
+// AddMixed adds two G1 points p1, p2 and assigns the result to point at first argument.
+// Expects the second point p2 in affine form.
+func (g *G1) AddMixed(r, p1, p2 *PointG1) *PointG1 {
+	g.addMixed((*point)(r), (*point)(p1), (*point)(p2))
+	return r
+}
+
+// Double doubles a G1 point p and assigns the result to the point at first argument.
+func (g *G1) Double(r, p *PointG1) *PointG1 {
+	g.double((*point)(r), (*point)(p))
+	return r
+}
+
+// Sub subtracts two G1 points p1, p2 and assigns the result to point at first argument.
+func (g *G1) Sub(c, a, b *PointG1) *PointG1 {
+	g.sub((*point)(c), (*point)(a), (*point)(b))
+	return c
+}
+
+// Neg negates a G1 point p and assigns the result to the point at first argument.
+func (g *G1) Neg(r, p *PointG1) *PointG1 {
+	g.neg((*point)(r), (*point)(p))
+	return r
+}
+
+// MulScalar multiplies a G1 point by given scalar value in big.Int and assigns the result to point at first argument.
+// Scalar is reduced modulo q so that negative values and values not less than q are accepted.
+func (g *G1) MulScalar(r, p *PointG1, e *big.Int) *PointG1 {
+	g.glvMul((*point)(r), (*point)(p), e)
+	return r
+}
+
+// MulScalarFr multiplies a G1 point by given scalar field element and assigns the result to point at first argument.
+func (g *G1) MulScalarFr(r, p *PointG1, e *Fr) *PointG1 {
+	g.glvMulFr((*point)(r), (*point)(p), e)
+	return r
+}
+
+// MulScalarCT multiplies a G1 point by given secret scalar in constant time and assigns the result to point at first argument.
+// Input point is expected to be in correct subgroup. MulScalarCT is slower than MulScalarFr
+// and should be used where the scalar must not leak such as signing and key generation. Result is normalized
+// to affine form with constant time inversion so that a following Affine call does not invert the secret dependent point.
+func (g *G1) MulScalarCT(r, p *PointG1, e *Fr) *PointG1 {
+	g.mulScalarCT((*point)(r), (*point)(p), e)
+	return r
+}
+
+// MultiExp calculates multi exponentiation. Given pairs of G1 point and scalar values
+// (P_0, e_0), (P_1, e_1), ... (P_n, e_n) calculates r = e_0 * P_0 + e_1 * P_1 + ... + e_n * P_n
+// Scalars are reduced modulo q so that negative values and values not less than q are accepted.
+// Points are not required to be in correct subgroup while the reduction changes the result
+// for points out of correct subgroup unless scalars are in [0, q).
+// Length of points and scalars are expected to be equal, otherwise ErrLengthMismatch is returned.
+// Nil entries are rejected with ErrNilPoint or ErrNilScalar. Empty input results in zero.
+// Result is assigned to point at first argument.
+func (g *G1) MultiExp(r *PointG1, points []*PointG1, scalars []*big.Int) (*PointG1, error) {
+	if _, err := g.multiExpBig((*point)(r), pointsG1(points), scalars); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// MultiExpFr calculates multi exponentiation where scalars are given as scalar field elements.
+// Result is exact for any point on curve including points out of correct subgroup.
+// Length of points and scalars are expected to be equal, otherwise ErrLengthMismatch is returned.
+// Nil entries are rejected with ErrNilPoint or ErrNilScalar.
+// Result is assigned to point at first argument.
+func (g *G1) MultiExpFr(r *PointG1, points []*PointG1, scalars []*Fr) (*PointG1, error) {
+	if _, err := g.multiExpFr((*point)(r), pointsG1(points), scalars); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// MultiExpParallel calculates multi exponentiation as MultiExpFr where windows and chunks of points
+// are distributed across given number of goroutines. If workers is not positive GOMAXPROCS is used.
+// Length of points and scalars are expected to be equal, otherwise ErrLengthMismatch is returned.
+// Nil entries are rejected with ErrNilPoint or ErrNilScalar.
+// Result is assigned to point at first argument.
+func (g *G1) MultiExpParallel(r *PointG1, points []*PointG1, scalars []*Fr, workers int) (*PointG1, error) {
+	if _, err := g.multiExpFrParallel((*point)(r), pointsG1(points), scalars, workers, false); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// MultiExpGLV calculates multi exponentiation as MultiExpParallel where each scalar is split into
+// two halves over P and φ(P) with the GLV endomorphism. All points must be in correct subgroup,
+// for example checked with InCorrectSubgroup, otherwise the result is wrong.
+func (g *G1) MultiExpGLV(r *PointG1, points []*PointG1, scalars []*Fr, workers int) (*PointG1, error) {
+	if _, err := g.multiExpFrParallel((*point)(r), pointsG1(points), scalars, workers, true); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ClearCofactor maps given a G1 point to correct subgroup.
+// Result is h1(x)P + h2(x)φ(P) with h1(x) = 103x^3 - 83x^2 - 40x + 136 and h2(x) = 7x^2 + 89x + 130
+// which is a multiple of [cofactor]P by a constant that is coprime to q.
+// https://eprint.iacr.org/2020/351
+func (g *G1) ClearCofactor(p *PointG1) *PointG1 {
+	g.mulEndoPoly((*point)(p), (*point)(p), clearCofactorG1H1, clearCofactorG1H2)
+	return p
+}
+
+// InCorrectSubgroup checks whether given G1 point is in correct subgroup.
+func (g *G1) InCorrectSubgroup(p *PointG1) bool {
+	return g.subgroupCheck((*point)(p))
+}
+
+// subgroupCheck uses the endomorphism φ(x, y) = (ω * x, y) where φ(P) = [λ]P in G1.
+// (x + 1, x^3 - x^2 + 1) is a short vector of the lattice spanned by (q, 0) and (-λ, 1)
+// so that a point P is in G1 if and only if (x + 1)P + (x^3 - x^2 + 1)φ(P) = 0.
+// https://eprint.iacr.org/2022/352
+func (g *G1) subgroupCheck(p *point) bool {
+	if g.isZero(p) {
+		return true
+	}
+	phiP := new(point).set(p)
+	mul(&phiP[0], &phiP[0], glvPhi1) // φ(P) in jacobian coordinates
+	t0, t1 := new(point), new(point)
+	g.mulX(t0, phiP)    // xφ(P)
+	g.sub(t0, t0, phiP) // (x - 1)φ(P)
+	g.mulX(t0, t0)      // (x^2 - x)φ(P)
+	g.mulX(t0, t0)      // (x^3 - x^2)φ(P)
+	g.add(t0, t0, phiP) // (x^3 - x^2 + 1)φ(P)
+	g.mulX(t1, p)       // xP
+	g.add(t1, t1, p)    // (x + 1)P
+	g.add(t0, t0, t1)   // (x + 1)P + (x^3 - x^2 + 1)φ(P)
+	return g.isZero(t0)
+}
+
+// HashToCurve hashes the message to a G1 point with hash_to_curve method of RFC 9380
+// using expand_message_xmd with SHA-256 and Shallue-van de Woestijne map.
+// Cofactor is cleared with ClearCofactor rather than by multiplying with h_eff.
+// Suite ID of the method is HashToCurveSuiteG1.
+func (g *G1) HashToCurve(msg, domain []byte) (*PointG1, error) {
+	p, err := g.hashToCurve(msg, domain, svdwParamsG1)
+	if err != nil {
+		return nil, err
+	}
+	return g.ClearCofactor((*PointG1)(p)), nil
+}
+
+// EncodeToCurve hashes the message to a G1 point with encode_to_curve method of RFC 9380
+// which is cheaper than HashToCurve while the output distribution is not uniform.
+// Suite ID of the method is EncodeToCurveSuiteG1.
+func (g *G1) EncodeToCurve(msg, domain []byte) (*PointG1, error) {
+	p, err := g.encodeToCurve(msg, domain, svdwParamsG1)
+	if err != nil {
+		return nil, err
+	}
+	return g.ClearCofactor((*PointG1)(p)), nil
+}
+
+func pointsG1(in []*PointG1) []*point {
+	out := make([]*point, len(in))
+	for i := 0; i < len(in); i++ {
+		out[i] = (*point)(in[i])
+	}
+	return out
+}
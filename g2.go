@@ -0,0 +1,311 @@
+package bw6
+
+import (
+	"math/big"
+)
+
+// coefficients of h1 and h2 in ascending degree order for cofactor clearing in G2
+var clearCofactorG2H1 = []int64{136, -26, -90, 103}
+var clearCofactorG2H2 = []int64{109, 117, -7, 0}
+
+// Suite IDs of hashing to G2 that are expected to be included in domain separation tags.
+const (
+	HashToCurveSuiteG2   = "BW6761G2_XMD:SHA-256_SVDW_RO_"
+	EncodeToCurveSuiteG2 = "BW6761G2_XMD:SHA-256_SVDW_NU_"
+)
+
+// PointG2 is type for point in G2 and used for both affine and Jacobian representation.
+// A point is accounted as in affine form if z is equal to one.
+type PointG2 [3]fe
+
+// Set sets the point p2 to p
+func (p *PointG2) Set(p2 *PointG2) *PointG2 {
+	(*point)(p).set((*point)(p2))
+	return p
+}
+
+// Zero sets point p as point at infinity
+func (p *PointG2) Zero() *PointG2 {
+	(*point)(p).zero()
+	return p
+}
+
+// IsAffine checks a G2 point whether it is in affine form.
+func (p *PointG2) IsAffine() bool {
+	return p[2].isOne()
+}
+
+// G2 is struct for G2 group.
+type G2 struct {
+	group
+}
+
+// NewG2 constructs a new G2 instance.
+func NewG2() *G2 {
+	return &G2{newGroup(b2, glvPhi2, cofactorG2)}
+}
+
+// Q returns group order in big.Int.
+func (g *G2) Q() *big.Int {
+	return new(big.Int).Set(q)
+}
+
+// FromBytes constructs a new point given either compressed or uncompressed byte input.
+// Encoding is determined by the compression flag which is the most significant bit of the input.
+// Uncompressed input is expected to be equal to 192 bytes and concatenation of x and y cooridanates.
+// Compressed input is expected to be equal to 96 bytes and x coordinate with sign of y in the flag bits.
+// Non canonical encodings are rejected except all zero uncompressed input which is the encoding
+// of infinity prior to flag bits and is still accepted.
+func (g *G2) FromBytes(in []byte) (*PointG2, error) {
+	p, err := g.fromBytes(in)
+	if err != nil {
+		return nil, err
+	}
+	return (*PointG2)(p), nil
+}
+
+// FromCompressed constructs a new point given compressed byte input.
+// Input string is expected to be equal to 96 bytes.
+func (g *G2) FromCompressed(in []byte) (*PointG2, error) {
+	p, err := g.fromCompressed(in)
+	if err != nil {
+		return nil, err
+	}
+	return (*PointG2)(p), nil
+}
+
+// ToBytes serializes a point into bytes in uncompressed form.
+// Infinity is encoded with infinity flag and remaining bits set to zero.
+func (g *G2) ToBytes(p *PointG2) []byte {
+	return g.toBytes((*point)(p))
+}
+
+// ToCompressed serializes a point into bytes in compressed form.
+// Compression flag is set, sign flag is set if y is lexicographically largest one.
+func (g *G2) ToCompressed(p *PointG2) []byte {
+	return g.toCompressed((*point)(p))
+}
+
+// New creates a new G2 Point which is equal to zero in other words point at infinity.
+func (g *G2) New() *PointG2 {
+	return g.Zero()
+}
+
+// Zero returns a new G2 Point which is equal to point at infinity.
+func (g *G2) Zero() *PointG2 {
+	return new(PointG2).Zero()
+}
+
+// One returns a new G2 generator.
+func (g *G2) One() *PointG2 {
+	return new(PointG2).Set(&g2One)
+}
+
+// IsZero returns true if given point is equal to zero.
+func (g *G2) IsZero(p *PointG2) bool {
+	return g.isZero((*point)(p))
+}
+
+// Equal checks if given two G2 point is equal in their affine form.
+func (g *G2) Equal(p1, p2 *PointG2) bool {
+	return g.equal((*point)(p1), (*point)(p2))
+}
+
+// IsOnCurve checks if G2 point is on curve.
+func (g *G2) IsOnCurve(p *PointG2) bool {
+	return g.isOnCurve((*point)(p))
+}
+
+// IsAffine checks a G2 point whether it is in affine form.
+func (g *G2) IsAffine(p *PointG2) bool {
+	return p.IsAffine()
+}
+
+// Affine returns the affine representation of the given point
+func (g *G2) Affine(p *PointG2) *PointG2 {
+	g.affine((*point)(p), (*point)(p))
+	return p
+}
+
+// AffineBatch given multiple of points returns affine representations
+func (g *G2) AffineBatch(p []*PointG2) {
+	g.affineBatch(pointsG2(p))
+}
+
+// Add adds two G2 points p1, p2 and assigns the result to point at first argument.
+func (g *G2) Add(r, p1, p2 *PointG2) *PointG2 {
+	g.add((*point)(r), (*point)(p1), (*point)(p2))
+	return r
+}
+
+// AddMixed adds two G2 points p1, p2 and assigns the result to point at first argument.
+// Expects the second point p2 in affine form.
+func (g *G2) AddMixed(r, p1, p2 *PointG2) *PointG2 {
+	g.addMixed((*point)(r), (*point)(p1), (*point)(p2))
+	return r
+}
+
+// Double doubles a G2 point p and assigns the result to the point at first argument.
+func (g *G2) Double(r, p *PointG2) *PointG2 {
+	g.double((*point)(r), (*point)(p))
+	return r
+}
+
+// Sub subtracts two G2 points p1, p2 and assigns the result to point at first argument.
+func (g *G2) Sub(c, a, b *PointG2) *PointG2 {
+	g.sub((*point)(c), (*point)(a), (*point)(b))
+	return c
+}
+
+// Neg negates a G2 point p and assigns the result to the point at first argument.
+func (g *G2) Neg(r, p *PointG2) *PointG2 {
+	g.neg((*point)(r), (*point)(p))
+	return r
+}
+
+// MulScalar multiplies a G2 point by given scalar value in big.Int and assigns the result to point at first argument.
+// Scalar is reduced modulo q so that negative values and values not less than q are accepted.
+func (g *G2) MulScalar(r, p *PointG2, e *big.Int) *PointG2 {
+	g.glvMul((*point)(r), (*point)(p), e)
+	return r
+}
+
+// MulScalarFr multiplies a G2 point by given scalar field element and assigns the result to point at first argument.
+func (g *G2) MulScalarFr(r, p *PointG2, e *Fr) *PointG2 {
+	g.glvMulFr((*point)(r), (*point)(p), e)
+	return r
+}
+
+// MulScalarCT multiplies a G2 point by given secret scalar in constant time and assigns the result to point at first argument.
+// Input point is expected to be in correct subgroup. MulScalarCT is slower than MulScalarFr
+// and should be used where the scalar must not leak such as signing and key generation. Result is normalized
+// to affine form with constant time inversion so that a following Affine call does not invert the secret dependent point.
+func (g *G2) MulScalarCT(r, p *PointG2, e *Fr) *PointG2 {
+	g.mulScalarCT((*point)(r), (*point)(p), e)
+	return r
+}
+
+// MultiExp calculates multi exponentiation. Given pairs of G2 point and scalar values
+// (P_0, e_0), (P_1, e_1), ... (P_n, e_n) calculates r = e_0 * P_0 + e_1 * P_1 + ... + e_n * P_n
+// Scalars are reduced modulo q so that negative values and values not less than q are accepted.
+// Points are not required to be in correct subgroup while the reduction changes the result
+// for points out of correct subgroup unless scalars are in [0, q).
+// Length of points and scalars are expected to be equal, otherwise ErrLengthMismatch is returned.
+// Nil entries are rejected with ErrNilPoint or ErrNilScalar. Empty input results in zero.
+// Result is assigned to point at first argument.
+func (g *G2) MultiExp(r *PointG2, points []*PointG2, scalars []*big.Int) (*PointG2, error) {
+	if _, err := g.multiExpBig((*point)(r), pointsG2(points), scalars); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// MultiExpFr calculates multi exponentiation where scalars are given as scalar field elements.
+// Result is exact for any point on curve including points out of correct subgroup.
+// Length of points and scalars are expected to be equal, otherwise ErrLengthMismatch is returned.
+// Nil entries are rejected with ErrNilPoint or ErrNilScalar.
+// Result is assigned to point at first argument.
+func (g *G2) MultiExpFr(r *PointG2, points []*PointG2, scalars []*Fr) (*PointG2, error) {
+	if _, err := g.multiExpFr((*point)(r), pointsG2(points), scalars); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// MultiExpParallel calculates multi exponentiation as MultiExpFr where windows and chunks of points
+// are distributed across given number of goroutines. If workers is not positive GOMAXPROCS is used.
+// Length of points and scalars are expected to be equal, otherwise ErrLengthMismatch is returned.
+// Nil entries are rejected with ErrNilPoint or ErrNilScalar.
+// Result is assigned to point at first argument.
+func (g *G2) MultiExpParallel(r *PointG2, points []*PointG2, scalars []*Fr, workers int) (*PointG2, error) {
+	if _, err := g.multiExpFrParallel((*point)(r), pointsG2(points), scalars, workers, false); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// MultiExpGLV calculates multi exponentiation as MultiExpParallel where each scalar is split into
+// two halves over P and φ(P) with the GLV endomorphism. All points must be in correct subgroup,
+// for example checked with InCorrectSubgroup, otherwise the result is wrong.
+func (g *G2) MultiExpGLV(r *PointG2, points []*PointG2, scalars []*Fr, workers int) (*PointG2, error) {
+	if _, err := g.multiExpFrParallel((*point)(r), pointsG2(points), scalars, workers, true); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ClearCofactor maps given a G2 point to correct subgroup.
+// Result is h1(x)P + h2(x)φ(P) with h1(x) = 103x^3 - 90x^2 - 26x + 136 and h2(x) = -7x^2 + 117x + 109
+// which is a multiple of [cofactor]P by a constant that is coprime to q.
+// https://eprint.iacr.org/2020/351
+func (g *G2) ClearCofactor(p *PointG2) *PointG2 {
+	g.mulEndoPoly((*point)(p), (*point)(p), clearCofactorG2H1, clearCofactorG2H2)
+	return p
+}
+
+// InCorrectSubgroup checks whether given G2 point is in correct subgroup.
+func (g *G2) InCorrectSubgroup(p *PointG2) bool {
+	return g.subgroupCheck((*point)(p))
+}
+
+// subgroupCheck uses the endomorphism φ(x, y) = (ω * x, y) where φ(P) = [λ]P in G2.
+// With u = (x - 1) / 3, (9u^3 + 6u^2 + 2u + 1, 18u^3 + 12u^2 + u) is a short vector of the lattice
+// spanned by (q, 0) and (-λ, 1) so that a point P is in G2 if and only if
+// (9u^3 + 6u^2 + 2u + 1)P + (18u^3 + 12u^2 + u)φ(P) = 0.
+// Short vectors with polynomials in x such as (x^3 - x^2 - x, -x - 1) span a sublattice of index 3
+// and also vanish on points of order 3 in cofactor torsion of G2.
+// https://eprint.iacr.org/2022/352
+func (g *G2) subgroupCheck(p *point) bool {
+	if g.isZero(p) {
+		return true
+	}
+	phiP := new(point).set(p)
+	mul(&phiP[0], &phiP[0], glvPhi2) // φ(P) in jacobian coordinates
+	t0, t1, t2 := new(point), new(point), new(point)
+	g.double(t1, phiP)                 // 2φ(P)
+	g.add(t1, t1, p)                   // R = P + 2φ(P)
+	g.mulParam(t0, t1, xMinusOneOver3) // uR
+	g.add(t2, t0, t1)                  // (u + 1)R
+	g.double(t2, t2)                   // (2u + 2)R
+	g.add(t0, t0, t2)                  // (3u + 2)R
+	g.double(t2, t0)                   // (6u + 4)R
+	g.add(t0, t0, t2)                  // (9u + 6)R
+	g.mulParam(t0, t0, xMinusOneOver3) // (9u^2 + 6u)R
+	g.double(t2, p)                    // 2P
+	g.add(t2, t2, phiP)                // 2P + φ(P)
+	g.add(t0, t0, t2)                  // (9u^2 + 6u)R + 2P + φ(P)
+	g.mulParam(t0, t0, xMinusOneOver3) // (9u^3 + 6u^2)R + 2uP + uφ(P)
+	g.add(t0, t0, p)                   // (9u^3 + 6u^2 + 2u + 1)P + (18u^3 + 12u^2 + u)φ(P)
+	return g.isZero(t0)
+}
+
+// HashToCurve hashes the message to a G2 point with hash_to_curve method of RFC 9380
+// using expand_message_xmd with SHA-256 and Shallue-van de Woestijne map.
+// Cofactor is cleared with ClearCofactor rather than by multiplying with h_eff.
+// Suite ID of the method is HashToCurveSuiteG2.
+func (g *G2) HashToCurve(msg, domain []byte) (*PointG2, error) {
+	p, err := g.hashToCurve(msg, domain, svdwParamsG2)
+	if err != nil {
+		return nil, err
+	}
+	return g.ClearCofactor((*PointG2)(p)), nil
+}
+
+// EncodeToCurve hashes the message to a G2 point with encode_to_curve method of RFC 9380
+// which is cheaper than HashToCurve while the output distribution is not uniform.
+// Suite ID of the method is EncodeToCurveSuiteG2.
+func (g *G2) EncodeToCurve(msg, domain []byte) (*PointG2, error) {
+	p, err := g.encodeToCurve(msg, domain, svdwParamsG2)
+	if err != nil {
+		return nil, err
+	}
+	return g.ClearCofactor((*PointG2)(p)), nil
+}
+
+func pointsG2(in []*PointG2) []*point {
+	out := make([]*point, len(in))
+	for i := 0; i < len(in); i++ {
+		out[i] = (*point)(in[i])
+	}
+	return out
+}
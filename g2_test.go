@@ -0,0 +1,496 @@
+package bw6
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"testing"
+)
+
+func (g *G2) rand() *PointG2 {
+	return (*PointG2)(g.group.rand())
+}
+
+func (g *G2) randCorrect() *PointG2 {
+	p := g.ClearCofactor(g.rand())
+	if !g.InCorrectSubgroup(p) {
+		panic("must be in correct subgroup")
+	}
+	return p
+}
+
+func (g *G2) randAffine() *PointG2 {
+	return g.Affine(g.randCorrect())
+}
+
+func TestG2Serialization(t *testing.T) {
+	var err error
+	g := NewG2()
+	zero := g.Zero()
+	b0 := g.ToBytes(zero)
+	p0, err := g.FromBytes(b0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !g.IsZero(p0) {
+		t.Fatal("infinity serialization failed")
+	}
+	for i := 0; i < fuz; i++ {
+		a1 := g.rand()
+		b0 := g.ToBytes(a1)
+		b1, err := g.FromBytes(b0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !g.Equal(a1, b1) {
+			t.Fatal("serialization failed")
+		}
+	}
+}
+
+func TestG2SerializationCompressed(t *testing.T) {
+	g := NewG2()
+	zero := g.Zero()
+	b0 := g.ToCompressed(zero)
+	if len(b0) != fpByteSize {
+		t.Fatal("bad compressed length")
+	}
+	p0, err := g.FromCompressed(b0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !g.IsZero(p0) {
+		t.Fatal("infinity serialization failed")
+	}
+	for i := 0; i < fuz; i++ {
+		a0 := g.rand()
+		a1 := g.New()
+		g.Neg(a1, a0)
+		for _, a := range []*PointG2{a0, a1} {
+			b0 := g.ToCompressed(a)
+			b1, err := g.FromCompressed(b0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !g.Equal(a, b1) {
+				t.Fatal("compressed serialization failed")
+			}
+			b2, err := g.FromBytes(b0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !g.Equal(a, b2) {
+				t.Fatal("flag driven decoding of compressed input failed")
+			}
+		}
+	}
+}
+
+func TestG2AdditiveProperties(t *testing.T) {
+	g := NewG2()
+	t0, t1 := g.New(), g.New()
+	zero := g.Zero()
+	for i := 0; i < fuz; i++ {
+		a, b := g.rand(), g.rand()
+		g.Add(t0, a, zero)
+		if !g.Equal(t0, a) {
+			t.Fatal("a + 0 == a")
+		}
+		g.Add(t0, zero, zero)
+		if !g.Equal(t0, zero) {
+			t.Fatal("0 + 0 == 0")
+		}
+		g.Sub(t0, a, zero)
+		if !g.Equal(t0, a) {
+			t.Fatal("a - 0 == a")
+		}
+		g.Sub(t0, zero, zero)
+		if !g.Equal(t0, zero) {
+			t.Fatal("0 - 0 == 0")
+		}
+		g.Neg(t0, zero)
+		if !g.Equal(t0, zero) {
+			t.Fatal("- 0 == 0")
+		}
+		g.Sub(t0, zero, a)
+		g.Neg(t0, t0)
+		if !g.Equal(t0, a) {
+			t.Fatal(" - (0 - a) == a")
+		}
+		g.Double(t0, zero)
+		if !g.Equal(t0, zero) {
+			t.Fatal("2 * 0 == 0")
+		}
+		g.Double(t0, a)
+		g.Sub(t0, t0, a)
+		if !g.Equal(t0, a) {
+			t.Fatal("(2 * a) - a == a")
+		}
+		g.Add(t0, a, b)
+		g.Add(t1, b, a)
+		if !g.Equal(t0, t1) {
+			t.Fatal("a + b == b + a")
+		}
+		g.Sub(t0, a, b)
+		g.Sub(t1, b, a)
+		g.Neg(t1, t1)
+		if !g.Equal(t0, t1) {
+			t.Fatal("a - b == - ( b - a )")
+		}
+		c := g.rand()
+		g.Add(t0, a, b)
+		g.Add(t0, t0, c)
+		g.Add(t1, a, c)
+		g.Add(t1, t1, b)
+		if !g.Equal(t0, t1) {
+			t.Fatal("(a + b) + c == (a + c ) + b")
+		}
+		g.Sub(t0, a, b)
+		g.Sub(t0, t0, c)
+		g.Sub(t1, a, c)
+		g.Sub(t1, t1, b)
+		if !g.Equal(t0, t1) {
+			t.Fatal("(a - b) - c == (a - c) -b")
+		}
+	}
+}
+
+func TestG2MultiplicativeProperties(t *testing.T) {
+	g := NewG2()
+	t0, t1 := g.New(), g.New()
+	zero := g.Zero()
+	for i := 0; i < fuz; i++ {
+		a := g.randAffine()
+		s1, s2, s3 := randScalar(q), randScalar(q), randScalar(q)
+		sone := big.NewInt(1)
+		g.MulScalar(t0, zero, s1)
+		if !g.Equal(t0, zero) {
+			t.Fatal("0 ^ s == 0")
+		}
+		g.MulScalar(t0, a, sone)
+		if !g.Equal(t0, a) {
+			t.Fatal("a ^ 1 == a")
+		}
+		g.MulScalar(t0, zero, s1)
+		if !g.Equal(t0, zero) {
+			t.Fatal("0 ^ s == a")
+		}
+		g.MulScalar(t0, a, s1)
+		g.MulScalar(t0, t0, s2)
+		s3.Mul(s1, s2)
+		g.MulScalar(t1, a, s3)
+		if !g.Equal(t0, t1) {
+			t.Fatal("(a ^ s1) ^ s2 == a ^ (s1 * s2)")
+		}
+		g.MulScalar(t0, a, s1)
+		g.MulScalar(t1, a, s2)
+		g.Add(t0, t0, t1)
+		s3.Add(s1, s2)
+		g.MulScalar(t1, a, s3)
+		if !g.Equal(t0, t1) {
+			t.Fatal("(a ^ s1) + (a ^ s2) == a ^ (s1 + s2)")
+		}
+	}
+}
+
+func TestG2MultiplicationCross(t *testing.T) {
+	g := NewG2()
+	for i := 0; i < fuz; i++ {
+		a := g.randCorrect()
+		s := randScalar(q)
+		res0, res1, res2, res3 := g.New(), g.New(), g.New(), g.New()
+		res4, res5 := g.New(), g.New()
+
+		g.mulScalar((*point)(res0), (*point)(a), s)
+		g.wnafMul((*point)(res1), (*point)(a), s)
+		g.glvMul((*point)(res2), (*point)(a), s)
+		_, _ = g.MultiExp(res3, []*PointG2{a}, []*big.Int{s})
+		g.MulScalarFr(res4, a, FrFromBig(s))
+		_, _ = g.MultiExpFr(res5, []*PointG2{a}, []*Fr{FrFromBig(s)})
+
+		if !g.Equal(res0, res1) {
+			t.Fatal("cross multiplication failed (wnaf)", i)
+		}
+		if !g.Equal(res0, res2) {
+			t.Fatal("cross multiplication failed (glv)", i)
+		}
+		if !g.Equal(res0, res3) {
+			t.Fatal("cross multiplication failed (multiexp)", i)
+		}
+		if !g.Equal(res0, res4) {
+			t.Fatal("cross multiplication failed (fr)", i)
+		}
+		if !g.Equal(res0, res5) {
+			t.Fatal("cross multiplication failed (multiexp fr)", i)
+		}
+	}
+}
+
+func TestG2MulScalarCT(t *testing.T) {
+	g := NewG2()
+	qMinus1 := new(big.Int).Sub(q, big.NewInt(1))
+	scalars := []*big.Int{big.NewInt(0), big.NewInt(1), big.NewInt(2), big.NewInt(3), big.NewInt(16), qMinus1}
+	for i := 0; i < fuz; i++ {
+		scalars = append(scalars, randScalar(q))
+	}
+	for _, s := range scalars {
+		a := g.randCorrect()
+		expected, r := g.New(), g.New()
+		g.MulScalar(expected, a, s)
+		g.MulScalarCT(r, a, FrFromBig(s))
+		if !g.Equal(expected, r) {
+			t.Fatal("constant time multiplication failed", s)
+		}
+		if !(*point)(r).isAffine() && !g.IsZero(r) {
+			t.Fatal("constant time multiplication must return affine point")
+		}
+		g.MulScalarCT(r, g.Zero(), FrFromBig(s))
+		if !g.IsZero(r) {
+			t.Fatal("multiplication of zero must be zero")
+		}
+	}
+	// non affine input
+	a, r, expected := g.randCorrect(), g.New(), g.New()
+	g.Double(a, a)
+	s := randScalar(q)
+	g.MulScalar(expected, a, s)
+	g.MulScalarCT(r, a, FrFromBig(s))
+	if !g.Equal(expected, r) {
+		t.Fatal("constant time multiplication failed for non affine input")
+	}
+}
+
+func TestG2MultiExp(t *testing.T) {
+	g := NewG2()
+	for n := 1; n < 64+1; n = n * 2 {
+		bases := make([]*PointG2, n)
+		scalars := make([]*big.Int, n)
+		var err error
+		for i := 0; i < n; i++ {
+			scalars[i], err = rand.Int(rand.Reader, q)
+			if err != nil {
+				t.Fatal(err)
+			}
+			bases[i] = g.randAffine()
+		}
+		expected, tmp := g.New(), g.New()
+		for i := 0; i < n; i++ {
+			g.mulScalar((*point)(tmp), (*point)(bases[i]), scalars[i])
+			g.Add(expected, expected, tmp)
+		}
+		result := g.New()
+		_, _ = g.MultiExp(result, bases, scalars)
+		if !g.Equal(expected, result) {
+			t.Fatal("multi-exponentiation failed")
+		}
+		scalarsFr := make([]*Fr, n)
+		for i := 0; i < n; i++ {
+			scalarsFr[i] = FrFromBig(scalars[i])
+		}
+		_, _ = g.MultiExpFr(result, bases, scalarsFr)
+		if !g.Equal(expected, result) {
+			t.Fatal("multi-exponentiation failed (fr)")
+		}
+	}
+}
+
+func TestG2ClearCofactor(t *testing.T) {
+	g := NewG2()
+	// ClearCofactor(P) = [k * cofactor]P where k = (h1(x) + h2(x)λ) / cofactor mod q
+	k := new(big.Int).Mul(evalPolyX(clearCofactorG2H2), glvLambda)
+	k.Add(k, evalPolyX(clearCofactorG2H1))
+	k.Mul(k, new(big.Int).ModInverse(cofactorG2, q)).Mod(k, q)
+	if k.Sign() == 0 {
+		t.Fatal("cofactor clearing must not map to zero")
+	}
+	for i := 0; i < fuz; i++ {
+		a := g.rand()
+		if g.InCorrectSubgroup(a) {
+			t.Fatal("near 0 probablity that this would occur")
+		}
+		expected := g.New().Set(a)
+		g.clearCofactor((*point)(expected))
+		g.MulScalar(expected, expected, k)
+		g.ClearCofactor(a)
+		if !g.InCorrectSubgroup(a) {
+			t.Fatal("cofactor is not cleared")
+		}
+		if !g.Equal(a, expected) {
+			t.Fatal("endomorphism based cofactor clearing does not agree with naive one")
+		}
+	}
+}
+
+func TestG2SubgroupCheck(t *testing.T) {
+	g := NewG2()
+	if !g.InCorrectSubgroup(g.Zero()) || !g.InCorrectSubgroup(g.One()) {
+		t.Fatal("zero and generator must be in correct subgroup")
+	}
+	for i := 0; i < fuz; i++ {
+		// point out of subgroup
+		a := g.rand()
+		if g.InCorrectSubgroup(a) != g.inCorrectSubgroup((*point)(a)) {
+			t.Fatal("fast subgroup check does not agree with slow one")
+		}
+		if g.InCorrectSubgroup(a) {
+			t.Fatal("point out of subgroup is accepted")
+		}
+		// point in subgroup
+		b := g.randCorrect()
+		if !g.InCorrectSubgroup(b) {
+			t.Fatal("point in subgroup is rejected")
+		}
+		// point in cofactor torsion
+		c := g.New()
+		g.wnafMul((*point)(c), (*point)(a), q)
+		if g.IsZero(c) {
+			t.Fatal("near 0 probablity that this would occur")
+		}
+		if g.InCorrectSubgroup(c) || g.inCorrectSubgroup((*point)(c)) {
+			t.Fatal("point in cofactor torsion is accepted")
+		}
+		// sum of points in subgroup and in cofactor torsion
+		g.Add(c, c, b)
+		if g.InCorrectSubgroup(c) || g.inCorrectSubgroup((*point)(c)) {
+			t.Fatal("point with torsion component is accepted")
+		}
+	}
+	// cofactor of G2 is 3 * 13 * h' so that points of order 3 and 13 are found as [q * cofactor / l]P
+	for _, l := range []int64{3, 13} {
+		e := new(big.Int).Div(cofactorG2, big.NewInt(l))
+		e.Mul(e, q)
+		for i := 0; i < fuz; i++ {
+			c := g.New()
+			g.wnafMul((*point)(c), (*point)(g.rand()), e)
+			if g.IsZero(c) {
+				continue
+			}
+			d := g.New()
+			g.mulScalar((*point)(d), (*point)(c), big.NewInt(l))
+			if !g.IsZero(d) {
+				t.Fatal("point is expected to be in small order", l)
+			}
+			if g.InCorrectSubgroup(c) != g.inCorrectSubgroup((*point)(c)) || g.InCorrectSubgroup(c) {
+				t.Fatal("point in small order is accepted", l)
+			}
+			g.Add(c, c, g.randCorrect())
+			if g.InCorrectSubgroup(c) != g.inCorrectSubgroup((*point)(c)) || g.InCorrectSubgroup(c) {
+				t.Fatal("point with small order component is accepted", l)
+			}
+		}
+	}
+}
+
+func BenchmarkG2MulGLV(t *testing.B) {
+	g := NewG2()
+	p := new(PointG2).Set(&g2One)
+	s := randScalar(q)
+	res := new(PointG2)
+	t.Run("Naive", func(t *testing.B) {
+		t.ResetTimer()
+		for i := 0; i < t.N; i++ {
+			g.mulScalar((*point)(res), (*point)(p), s)
+		}
+	})
+	for i := 1; i < 8; i++ {
+		glvMulWindow = uint(i)
+		t.Run(fmt.Sprintf("window: %d", i), func(t *testing.B) {
+			t.ResetTimer()
+			for i := 0; i < t.N; i++ {
+				g.glvMul((*point)(res), (*point)(p), s)
+			}
+		})
+	}
+}
+
+func BenchmarkG2MultiExp(t *testing.B) {
+	g := NewG2()
+	v := func(n int) ([]*PointG2, []*big.Int) {
+		bases := make([]*PointG2, n)
+		scalars := make([]*big.Int, n)
+		var err error
+		for i := 0; i < n; i++ {
+			scalars[i] = randScalar(q)
+			if err != nil {
+				t.Fatal(err)
+			}
+			bases[i] = g.randAffine()
+		}
+		return bases, scalars
+	}
+	for _, i := range []int{1, 2, 10, 100, 1000} {
+		t.Run(fmt.Sprint(i), func(t *testing.B) {
+			bases, scalars := v(i)
+			result := g.New()
+			t.ResetTimer()
+			for i := 0; i < t.N; i++ {
+				_, _ = g.MultiExp(result, bases, scalars)
+			}
+		})
+	}
+}
+
+func BenchmarkG2ClearCofactor(t *testing.B) {
+	g := NewG2()
+	a := g.rand()
+	t.Run("Naive", func(t *testing.B) {
+		t.ResetTimer()
+		for i := 0; i < t.N; i++ {
+			g.clearCofactor((*point)(a))
+		}
+	})
+	t.Run("Endomorphism", func(t *testing.B) {
+		t.ResetTimer()
+		for i := 0; i < t.N; i++ {
+			g.ClearCofactor(a)
+		}
+	})
+}
+
+func BenchmarkG2SubgroupCheck(t *testing.B) {
+	g := NewG2()
+	a := g.randCorrect()
+	t.Run("Naive", func(t *testing.B) {
+		t.ResetTimer()
+		for i := 0; i < t.N; i++ {
+			g.inCorrectSubgroup((*point)(a))
+		}
+	})
+	t.Run("Endomorphism", func(t *testing.B) {
+		t.ResetTimer()
+		for i := 0; i < t.N; i++ {
+			g.InCorrectSubgroup(a)
+		}
+	})
+}
+
+func BenchmarkG2MulScalar(t *testing.B) {
+	g := NewG2()
+	p := g.randCorrect()
+	s := randScalar(q)
+	e := FrFromBig(s)
+	res := g.New()
+	t.Run("Big", func(t *testing.B) {
+		t.ReportAllocs()
+		for i := 0; i < t.N; i++ {
+			g.MulScalar(res, p, s)
+		}
+	})
+	t.Run("Fr", func(t *testing.B) {
+		t.ReportAllocs()
+		for i := 0; i < t.N; i++ {
+			g.MulScalarFr(res, p, e)
+		}
+	})
+}
+
+func BenchmarkG2MulScalarCT(t *testing.B) {
+	g := NewG2()
+	p := new(PointG2).Set(&g2One)
+	s := FrFromBig(randScalar(q))
+	res := new(PointG2)
+	t.ResetTimer()
+	for i := 0; i < t.N; i++ {
+		g.MulScalarCT(res, p, s)
+	}
+}
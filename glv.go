@@ -1,6 +1,9 @@
 package bw6
 
-import "math/big"
+import (
+	"math/big"
+	"math/bits"
+)
 
 var glvQ2 = bigFromHex("0x072030ba8ee9c0643042fb73b015bd4eeda5ba6bfab7176f0a")
 
@@ -28,15 +31,7 @@ type glvVector struct {
 }
 
 func (v *glvVector) wnaf(w uint) (nafNumber, nafNumber) {
-	naf1, naf2 := bigToWNAF(v.k1, w), bigToWNAF(v.k2, w)
-	zero := new(big.Int)
-	if v.k1.Cmp(zero) < 0 {
-		naf1.neg()
-	}
-	if v.k2.Cmp(zero) < 0 {
-		naf2.neg()
-	}
-	return naf1, naf2
+	return bigToWNAF(v.k1, w), bigToWNAF(v.k2, w)
 }
 
 func (v *glvVector) new(k *big.Int) *glvVector {
@@ -76,24 +71,93 @@ func (v *glvVector) new(k *big.Int) *glvVector {
 	return v
 }
 
-func (g *G) glvEndomorphismG1(r, p *Point) {
-	t := g.Affine(p)
-	if g.IsZero(p) {
-		r.Zero()
-		return
+// glvQ1Limbs and glvQ2Limbs are glvQ1 and glvQ2 in little endian limbs.
+var glvQ1Limbs = [4]uint64{0x7ccf39ddb5613b6f, 0x42fb73b015bd4e9e, 0x2030ba8ee9c06430, 0x0000000000000007}
+var glvQ2Limbs = [4]uint64{0xa5ba6bfab7176f0a, 0x42fb73b015bd4eed, 0x2030ba8ee9c06430, 0x0000000000000007}
+
+// glvB1Mont, glvB2Mont and glvLambdaMont are glvB1, glvB2 and glvLambda in Montgomery form
+// so that multiplying a canonical element by them gives a canonical product.
+var glvB1Mont = &Fr{0x1cae140548e890f6, 0xfff8c29d9851c255, 0x86b67d922c10033e, 0xc05529ebc3688c6d, 0x48d8d6810da67b31, 0x01a267bfeca0146f}
+var glvB2Mont = &Fr{0x102586224a9ec491, 0x5d0fc789b0b33a6e, 0xd998a0f7c2c6ff98, 0x4286154cac0dbf33, 0x3c9f0c705a60abb9, 0x00fd0edd5f3101cf}
+var glvLambdaMont = &Fr{0xdacd106da5847973, 0xd8fe2454bac2a79a, 0x1ada4fd6fd832edc, 0xfb9868449d150908, 0xd63eb8aeea32285e, 0x0167d6a36f873fd0}
+
+// frHalfModulus = (q - 1) / 2
+var frHalfModulus = &Fr{0x4284600000000000, 0x0b85aea218000000, 0x8f79b117dd04a400, 0x8d116cf9807a89c7, 0x631d82e03650a49d, 0x00d71d230be28875}
+
+// glvVectorFr is the same decomposition as glvVector calculated on limbs without big.Int arithmetic.
+// Halves are kept as absolute values in non Montgomery form together with their signs.
+type glvVectorFr struct {
+	k1, k2     Fr
+	neg1, neg2 bool
+}
+
+func (v *glvVectorFr) wnaf(w uint) (nafNumber, nafNumber) {
+	naf1, naf2 := v.k1.wnaf(w), v.k2.wnaf(w)
+	if v.neg1 {
+		naf1.neg()
 	}
-	r[1].set(&t[1])
-	mul(&r[0], &t[0], glvPhi1)
-	r[2].one()
+	if v.neg2 {
+		naf2.neg()
+	}
+	return naf1, naf2
+}
+
+// new decomposes a scalar in non Montgomery form. Halves are taken in (-q/2, q/2)
+// so that they are at most 189 bits.
+func (v *glvVectorFr) new(k *Fr) *glvVectorFr {
+	c1, c2 := new(Fr), new(Fr)
+	glvRound(c1, k, &glvQ1Limbs)
+	glvRound(c2, k, &glvQ2Limbs)
+	// k2 = c1 * b1 - c2 * b2
+	mulFR(c1, c1, glvB1Mont)
+	mulFR(c2, c2, glvB2Mont)
+	subFR(&v.k2, c1, c2)
+	// k1 = k - k2 * λ
+	mulFR(c1, &v.k2, glvLambdaMont)
+	subFR(&v.k1, k, c1)
+	v.neg1 = v.k1.cmp(frHalfModulus) == 1
+	if v.neg1 {
+		negFR(&v.k1, &v.k1)
+	}
+	v.neg2 = v.k2.cmp(frHalfModulus) == 1
+	if v.neg2 {
+		negFR(&v.k2, &v.k2)
+	}
+	return v
+}
+
+// glvRound sets c to round(k * m / 2^384) for a canonical scalar k.
+func glvRound(c, k *Fr, m *[4]uint64) {
+	var t [frNumberOfLimbs + 4]uint64
+	for i := 0; i < frNumberOfLimbs; i++ {
+		var carry uint64
+		for j := 0; j < 4; j++ {
+			hi, lo := bits.Mul64(k[i], m[j])
+			var c0 uint64
+			lo, c0 = bits.Add64(lo, t[i+j], 0)
+			hi += c0
+			lo, c0 = bits.Add64(lo, carry, 0)
+			hi += c0
+			t[i+j], carry = lo, hi
+		}
+		t[i+4] = carry
+	}
+	// halfR = 2^383
+	var carry uint64
+	t[5], carry = bits.Add64(t[5], 1<<63, 0)
+	for i := 6; i < len(t); i++ {
+		t[i], carry = bits.Add64(t[i], 0, carry)
+	}
+	*c = Fr{t[6], t[7], t[8], t[9], 0, 0}
 }
 
-func (g *G) glvEndomorphismG2(r, p *Point) {
-	t := g.Affine(p)
-	if g.IsZero(p) {
-		r.Zero()
+func (g *group) glvEndomorphism(r, p *point) {
+	t := g.affine(p, p)
+	if g.isZero(p) {
+		r.zero()
 		return
 	}
 	r[1].set(&t[1])
-	mul(&r[0], &t[0], glvPhi2)
+	mul(&r[0], &t[0], g.glvPhi)
 	r[2].one()
 }
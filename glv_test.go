@@ -29,26 +29,26 @@ func TestGLVConstruction(t *testing.T) {
 		}
 	})
 	t.Run("Endomorphism G1", func(t *testing.T) {
-		g := NewG()
+		g := NewG1()
 		{
-			p0, p1 := g.randG1Affine(), g.New()
-			g.mulScalar(p1, p0, glvLambda)
+			p0, p1 := g.randAffine(), g.New()
+			g.mulScalar((*point)(p1), (*point)(p0), glvLambda)
 			g.Affine(p1)
 			r := g.New()
-			g.glvEndomorphismG1(r, p0)
+			g.glvEndomorphism((*point)(r), (*point)(p0))
 			if !g.Equal(r, p1) {
 				t.Fatal("f(x, y) = (phi * x, y)")
 			}
 		}
 	})
 	t.Run("Endomorphism G2", func(t *testing.T) {
-		g := NewG()
+		g := NewG2()
 		{
-			p0, p1 := g.randG2Affine(), g.New()
-			g.mulScalar(p1, p0, glvLambda)
+			p0, p1 := g.randAffine(), g.New()
+			g.mulScalar((*point)(p1), (*point)(p0), glvLambda)
 			g.Affine(p1)
 			r := g.New()
-			g.glvEndomorphismG2(r, p0)
+			g.glvEndomorphism((*point)(r), (*point)(p0))
 			if !g.Equal(r, p1) {
 				t.Fatal("f(x, y) = (phi * x, y)")
 			}
@@ -80,4 +80,33 @@ func TestGLVConstruction(t *testing.T) {
 			}
 		}
 	})
+	t.Run("Scalar Decomposition Fr", func(t *testing.T) {
+		r189 := new(big.Int).Lsh(one, 189)
+		scalars := []*big.Int{zero, one, new(big.Int).Sub(q, one), new(big.Int).Rsh(q, 1)}
+		for i := 0; i < fuz; i++ {
+			scalars = append(scalars, randScalar(q))
+		}
+		for _, k := range scalars {
+			v := new(glvVectorFr).new(new(Fr).setBig(k))
+			k1, k2 := v.k1.big(), v.k2.big()
+			if k1.Cmp(r189) >= 0 || k2.Cmp(r189) >= 0 {
+				t.Fatal("bad scalar component", k)
+			}
+			if v.neg1 {
+				k1.Neg(k1)
+			}
+			if v.neg2 {
+				k2.Neg(k2)
+			}
+			_k := new(big.Int).Mul(glvLambda, k2)
+			_k.Add(k1, _k).Mod(_k, q)
+			if k.Cmp(_k) != 0 {
+				t.Fatal("scalar decomposing failed", k)
+			}
+			naf1, naf2 := v.wnaf(4)
+			if bigFromWNAF(naf1).Cmp(k1) != 0 || bigFromWNAF(naf2).Cmp(k2) != 0 {
+				t.Fatal("wnaf of scalar components failed", k)
+			}
+		}
+	})
 }
@@ -2,4 +2,7 @@ module github.com/kilic/bw6
 
 go 1.14
 
-require golang.org/x/sys v0.0.0-20200905004654-be1d3432aa8f
+require (
+	golang.org/x/crypto v0.0.0-20200820211705-5c72a883971a
+	golang.org/x/sys v0.0.0-20200905004654-be1d3432aa8f
+)
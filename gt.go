@@ -18,10 +18,9 @@ func (e *E) Set(e2 *E) *E {
 	return e.set(e2)
 }
 
-// One sets a new target group element to one
+// One sets the target group element to one
 func (e *E) One() *E {
-	e = new(fe6).one()
-	return e
+	return e.one()
 }
 
 // IsOne returns true if given element equals to one
@@ -0,0 +1,118 @@
+package bw6
+
+// Hashing to curve follows RFC 9380. Both G1 and G2 curves have j-invariant zero
+// so that Shallue-van de Woestijne method is used to map field elements to curve points.
+
+// svdwParams are constants of the Shallue-van de Woestijne map as described in RFC 9380 section 6.6.1.
+// c1 = g(z), c2 = -z / 2, c3 = sqrt(-g(z) * 3z^2) where sgn0(c3) = 0 and c4 = -4g(z) / 3z^2
+type svdwParams struct {
+	z, c1, c2, c3, c4 *fe
+}
+
+// svdwParamsG1 for y^2 = x^3 - 1 where z = -1
+var svdwParamsG1 = &svdwParams{
+	z:  &fe{0xf29a000000007ab6, 0x8c391832e000739b, 0x77738a6b6870f959, 0xbe36179047832b03, 0x84f3089e56574722, 0xc5a3614ac0b1d984, 0x5c81153f4906e9fe, 0x4d28be3a9f55c815, 0xd72c1d6f77d5f5c5, 0x73a18e069ac04458, 0xf9dfaa846595555f, 0x00d0f0a60a5be58c},
+	c1: &fe{0xf09700000000f4e1, 0x31e0f1fd5000e6b4, 0xd8da1c27e5f14e7b, 0xe3cb185e389ead0e, 0x98093d6038c28f16, 0x04bcf9a86e69b578, 0xb5336f7f6c59b0f9, 0x29d5d63c5926a711, 0x5bd5c4ef6f242d49, 0x2e1d03a2b3af4229, 0x22378bc8c62fab80, 0x007ef9271933fd0f},
+	c2: &fe{0xfb4fffffffffc330, 0x2074b24effffc6b4, 0x5a53337936b8278b, 0x39860afa32a61376, 0x2f634f8d48c05b9d, 0x23b81847b2a110ce, 0x558e305f8130ae05, 0xc9e7471b95da050e, 0xe6ec6737c49cc35e, 0xff5551673471245b, 0x5497f3fdd230548e, 0x00ba6fd1f655db44},
+	c3: &fe{0x3648efc987e2d6db, 0x00eeb5ad2c1c7f39, 0x35a2e56967a550ec, 0xddbdb31ed327be2a, 0xfbb01606d8360f0d, 0x105dc21290b94e21, 0x71dcea2d8e69f7d4, 0x66d164211a992e0e, 0x501d94637ba1af9f, 0xe77bee55faedfa77, 0x3804fc11bbe36dde, 0x00c62048146c7531},
+	c4: &fe{0x0cf4aaaaaaa96486, 0x01df919e8aa97766, 0x42e5d594bf5eaf81, 0xe0b1eeae98c6df3c, 0x2bdc3b680199eb55, 0x535fe3bd796f0c6a, 0x10efe8003355d60c, 0x688aa680222511f9, 0xbc8f480b16d4ed1f, 0xe89d60c366f72c23, 0x5e1076745067c57e, 0x0018a3e486128d48},
+}
+
+// svdwParamsG2 for y^2 = x^3 + 4 where z = 1
+var svdwParamsG2 = &svdwParams{
+	z:  &fe{0x0202ffffffff85d5, 0x5a5826358fff8ce7, 0x9e996e43827faade, 0xda6aff320ee47df4, 0xece9cb3e1d94b80b, 0xc0e667a25248240b, 0xa74da5bfdcad3905, 0x2352e7fe462f2103, 0x7b56588008b1c87c, 0x45848a63e711022f, 0xd7a81ebb9f65a9df, 0x0051f77ef127e87d},
+	c1: &fe{0x1571fffffffd9c9e, 0xdd2780a35ffdc000, 0x02f22ea2a18db21f, 0xab75e537f40ecccf, 0x2eb4245a1ffb990c, 0x3df63d3e886eb6ab, 0x40b581c029adfa18, 0x4022e1be7966bbf9, 0x162d4490aaf12c2b, 0xa2709b890183c465, 0x64c0d06a1801521d, 0x0076ed55ba43bc6a},
+	c2: &fe{0xf94d000000003d5b, 0xc61c8c19700039cd, 0xbbb9c535b4387cac, 0x5f1b0bc823c19581, 0x4279844f2b2ba391, 0x62d1b0a56058ecc2, 0xae408a9fa48374ff, 0xa6945f1d4faae40a, 0x6b960eb7bbeafae2, 0xb9d0c7034d60222c, 0x7cefd54232caaaaf, 0x00687853052df2c6},
+	c3: &fe{0x2c04670501330fa9, 0xe26d268c6fc2bfdf, 0x462fb38ca114d8fb, 0x34ab58a6df1b41ba, 0x449e0e9ba9a7b744, 0xba2cdeb49bf6bbd5, 0xcaf30b31dccd2645, 0x279cfeba08dbe7c2, 0xe7325541f4280829, 0x06a84e0be4fb9963, 0x0138de010bf24b74, 0x010499094ce297e2},
+	c4: &fe{0xd43955555558853c, 0x61e2525c15585603, 0x6ece62bb0c83ed75, 0xe6e4420dd8767ae1, 0x04363f586feb32d7, 0xb61a0f9363645e87, 0x5976f6fea55d8be5, 0xeb2105f890283c2a, 0xfb1c41d3c7736d72, 0x739ca6820067582e, 0xe65ea11d3bf79181, 0x00e54e69ac556cd5},
+}
+
+// cmov sets c to b if bit is one and to a if bit is zero without branching on bit.
+func cmov(c, a, b *fe, bit uint64) {
+	mask := -bit
+	for i := 0; i < fpNumberOfLimbs; i++ {
+		c[i] = a[i] ^ ((a[i] ^ b[i]) & mask)
+	}
+}
+
+// isSquare returns one if a is zero or a quadratic residue and zero otherwise.
+// Legendre symbol is computed with an exponentiation by the public exponent (p - 1) / 2
+// and compared to zero and one without branching.
+func isSquare(a *fe) uint64 {
+	l := new(fe)
+	exp(l, a, pMinus1Over2)
+	var isZero, isOne uint64
+	for i := 0; i < fpNumberOfLimbs; i++ {
+		isZero |= l[i]
+		isOne |= l[i] ^ r1[i]
+	}
+	return ctEq(isZero, 0) | ctEq(isOne, 0)
+}
+
+// sgn0 returns the parity of the canonical representation of a.
+func sgn0(a *fe) uint64 {
+	r := new(fe)
+	fromMont(r, a)
+	return r[0] & 1
+}
+
+// curveRHS computes x^3 + b.
+func (g *group) curveRHS(r, x *fe) *fe {
+	square(r, x)
+	mul(r, r, x)
+	add(r, r, g.b)
+	return r
+}
+
+// mapToCurveSVDW maps a field element to a curve point in affine form following RFC 9380 section 6.6.1.
+// Sequence of field operations does not depend on the input. Selections use masks, exponentiations
+// run over public exponents and inversion and negation are the constant time variants. Note that
+// field arithmetic itself may still branch on carries as noted in constant_time.go.
+func (g *group) mapToCurveSVDW(u *fe, c *svdwParams) *point {
+	tv1, tv2, tv3, tv4 := new(fe), new(fe), new(fe), new(fe)
+	x1, x2, x3, gx, y := new(fe), new(fe), new(fe), new(fe), new(fe)
+	square(tv1, u)
+	mul(tv1, tv1, c.c1)
+	add(tv2, one, tv1)
+	sub(tv1, one, tv1)
+	mul(tv3, tv1, tv2)
+	inverseCT(tv3, tv3)
+	mul(tv4, u, tv1)
+	mul(tv4, tv4, tv3)
+	mul(tv4, tv4, c.c3)
+	sub(x1, c.c2, tv4)
+	e1 := isSquare(g.curveRHS(gx, x1))
+	add(x2, c.c2, tv4)
+	e2 := isSquare(g.curveRHS(gx, x2)) &^ e1
+	square(x3, tv2)
+	mul(x3, x3, tv3)
+	square(x3, x3)
+	mul(x3, x3, c.c4)
+	add(x3, x3, c.z)
+	cmov(x3, x3, x1, e1)
+	cmov(x3, x3, x2, e2)
+	g.curveRHS(gx, x3)
+	exp(y, gx, pPlus1Over4)
+	negCT(tv1, y)
+	cmov(y, tv1, y, ctEq(sgn0(u), sgn0(y)))
+	return &point{*x3, *y, *new(fe).one()}
+}
+
+// hashToCurve maps the message to two points and returns their sum without clearing the cofactor.
+func (g *group) hashToCurve(msg, domain []byte, c *svdwParams) (*point, error) {
+	u, err := HashToFp(msg, domain, 2)
+	if err != nil {
+		return nil, err
+	}
+	p0, p1 := g.mapToCurveSVDW(u[0], c), g.mapToCurveSVDW(u[1], c)
+	return g.addMixed(p0, p0, p1), nil
+}
+
+// encodeToCurve maps the message to a single point without clearing the cofactor.
+func (g *group) encodeToCurve(msg, domain []byte, c *svdwParams) (*point, error) {
+	u, err := HashToFp(msg, domain, 1)
+	if err != nil {
+		return nil, err
+	}
+	return g.mapToCurveSVDW(u[0], c), nil
+}
@@ -0,0 +1,601 @@
+package bw6
+
+import (
+	"crypto/rand"
+	"testing"
+)
+
+// hashToCurveVector follows the layout of RFC 9380 appendix J.
+// Vectors are generated offline with an independent implementation of the suites.
+type hashToCurveVector struct {
+	msg string
+	p   [2]string
+	u   []string
+	q   [][2]string
+}
+
+func checkHashToCurveVector(t *testing.T, g *group, c *svdwParams, domain []byte, v hashToCurveVector, p *point) {
+	t.Helper()
+	u, err := HashToFp([]byte(v.msg), domain, len(v.u))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < len(v.u); i++ {
+		expected, err := fromString(v.u[i])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !u[i].equal(expected) {
+			t.Fatalf("hash to field failed, msg: %q", v.msg)
+		}
+		q := g.mapToCurveSVDW(u[i], c)
+		if toString(&q[0]) != v.q[i][0] || toString(&q[1]) != v.q[i][1] {
+			t.Fatalf("map to curve failed, msg: %q", v.msg)
+		}
+	}
+	g.affine(p, p)
+	if toString(&p[0]) != v.p[0] || toString(&p[1]) != v.p[1] {
+		t.Fatalf("hash to curve failed, msg: %q", v.msg)
+	}
+}
+
+func TestHashToCurveG1Vectors(t *testing.T) {
+	g := NewG1()
+	for _, vectors := range []struct {
+		domain  string
+		vectors []hashToCurveVector
+	}{hashToCurveVectorsG1, encodeToCurveVectorsG1} {
+		domain := []byte(vectors.domain)
+		for _, v := range vectors.vectors {
+			var p *PointG1
+			var err error
+			if len(v.q) == 2 {
+				p, err = g.HashToCurve([]byte(v.msg), domain)
+			} else {
+				p, err = g.EncodeToCurve([]byte(v.msg), domain)
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			checkHashToCurveVector(t, &g.group, svdwParamsG1, domain, v, (*point)(p))
+		}
+	}
+}
+
+func TestIsSquare(t *testing.T) {
+	in := []*fe{new(fe).zero(), new(fe).one()}
+	for i := 0; i < fuz; i++ {
+		e, _ := new(fe).rand(rand.Reader)
+		in = append(in, e)
+	}
+	for _, e := range in {
+		var expected uint64
+		if e.isZero() || !isQuadraticNonResidue(e) {
+			expected = 1
+		}
+		if isSquare(e) != expected {
+			t.Fatal("bad square check", e)
+		}
+	}
+}
+
+func TestHashToCurveG1(t *testing.T) {
+	g := NewG1()
+	domain := []byte("BW6761-TEST-" + HashToCurveSuiteG1)
+	for i := 0; i < fuz; i++ {
+		u, _ := new(fe).rand(rand.Reader)
+		p := g.mapToCurveSVDW(u, svdwParamsG1)
+		if !g.isOnCurve(p) {
+			t.Fatal("mapped point is not on curve")
+		}
+		if sgn0(u) != sgn0(&p[1]) {
+			t.Fatal("sign of y must match the sign of input")
+		}
+		msg := make([]byte, i)
+		_, _ = rand.Read(msg)
+		r, err := g.HashToCurve(msg, domain)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if g.IsZero(r) || !g.InCorrectSubgroup(r) {
+			t.Fatal("hashed point must be in correct subgroup")
+		}
+		r, err = g.EncodeToCurve(msg, domain)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if g.IsZero(r) || !g.InCorrectSubgroup(r) {
+			t.Fatal("encoded point must be in correct subgroup")
+		}
+	}
+	// exceptional input
+	p := g.mapToCurveSVDW(new(fe), svdwParamsG1)
+	if !g.isOnCurve(p) {
+		t.Fatal("mapped point is not on curve")
+	}
+}
+
+func BenchmarkHashToCurveG1(t *testing.B) {
+	g := NewG1()
+	domain := []byte("BW6761-BENCH-" + HashToCurveSuiteG1)
+	msg := make([]byte, 32)
+	t.ResetTimer()
+	for i := 0; i < t.N; i++ {
+		_, _ = g.HashToCurve(msg, domain)
+	}
+}
+
+func TestHashToCurveG2Vectors(t *testing.T) {
+	g := NewG2()
+	for _, vectors := range []struct {
+		domain  string
+		vectors []hashToCurveVector
+	}{hashToCurveVectorsG2, encodeToCurveVectorsG2} {
+		domain := []byte(vectors.domain)
+		for _, v := range vectors.vectors {
+			var p *PointG2
+			var err error
+			if len(v.q) == 2 {
+				p, err = g.HashToCurve([]byte(v.msg), domain)
+			} else {
+				p, err = g.EncodeToCurve([]byte(v.msg), domain)
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			checkHashToCurveVector(t, &g.group, svdwParamsG2, domain, v, (*point)(p))
+		}
+	}
+}
+
+func TestHashToCurveG2(t *testing.T) {
+	g := NewG2()
+	domain := []byte("BW6761-TEST-" + HashToCurveSuiteG2)
+	for i := 0; i < fuz; i++ {
+		u, _ := new(fe).rand(rand.Reader)
+		p := g.mapToCurveSVDW(u, svdwParamsG2)
+		if !g.isOnCurve(p) {
+			t.Fatal("mapped point is not on curve")
+		}
+		if sgn0(u) != sgn0(&p[1]) {
+			t.Fatal("sign of y must match the sign of input")
+		}
+		msg := make([]byte, i)
+		_, _ = rand.Read(msg)
+		r, err := g.HashToCurve(msg, domain)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if g.IsZero(r) || !g.InCorrectSubgroup(r) {
+			t.Fatal("hashed point must be in correct subgroup")
+		}
+		r, err = g.EncodeToCurve(msg, domain)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if g.IsZero(r) || !g.InCorrectSubgroup(r) {
+			t.Fatal("encoded point must be in correct subgroup")
+		}
+	}
+	// exceptional input
+	p := g.mapToCurveSVDW(new(fe), svdwParamsG2)
+	if !g.isOnCurve(p) {
+		t.Fatal("mapped point is not on curve")
+	}
+}
+
+func BenchmarkHashToCurveG2(t *testing.B) {
+	g := NewG2()
+	domain := []byte("BW6761-BENCH-" + HashToCurveSuiteG2)
+	msg := make([]byte, 32)
+	t.ResetTimer()
+	for i := 0; i < t.N; i++ {
+		_, _ = g.HashToCurve(msg, domain)
+	}
+}
+
+var hashToCurveVectorsG1 = struct {
+	domain  string
+	vectors []hashToCurveVector
+}{
+	"QUUX-V01-CS02-with-BW6761G1_XMD:SHA-256_SVDW_RO_",
+	[]hashToCurveVector{
+		{
+			msg: "",
+			p:   [2]string{
+				"0x00b5c6ecf29819d7b351c7d54da00a9b674b4a8b8d8192975c029b9bebc8874e89c288a63987870da039fd985bf388cb291f7b80fe2c5b95b80c577d506866ea93f6aa6a4ee5d3836e8567024dfbb40fc72c4e5cdf9d66aead20b6b120d1b319",
+				"0x00a25dae2b37e8d43c79ab9b83ec6360f4d5b7073dbe5c288710b2ce2f6135bc420e250fd130ae5b1fec7cbf457d331d850e982fb0a5adf473ac2ac331803b9772a7dd3e709d0319284af245923831c44e80072832de11281ea83c16d62e3481",
+			},
+			u: []string{
+				"0x009aa2e5b55415e73fd4ef865113258e66137042a1527ab217da7ba20e354846668c88ef6d122fee9a6d36c658cc96dd8c503456a8335a5cab72fe4e47305a54d671e07e613ec53a503cfc5055e93b7e15c6d6d99410f9e63bae4b30a13a580d",
+				"0x0066c66a97b09df49fcdb4786b99fc91ce8584753d6124ad0512e73f60099c5f02c34b74080b65f9b47afcc26c1c7298ed8935eb1f544cc132137859c0abe3650619bc00317f4ee29d030a0a9207d2f2e90151daddc4456cf1d199430738323c",
+			},
+			q: [][2]string{
+				{
+					"0x0115c4758ae1ec6f54edd33c29fa4d46e15da10a2017b105b7050e4bba2bb8d5d056c474e77c915b92ab64ecf985851017b5f27cbc4e0a0a3de9c93c0951fb03368b7693f04b56c80b8344a1911a7d06134672eeacbfe568c53b4bcfd31d5748",
+					"0x00981888265db9eb6604e4876b5a4ac8039ff3b44cd34cc6a1e3f7af29f081573d75930b1c4b5a2f4d06c2b70a068b39297a0760e9682be0a34a1498ec946b3cef8e070a85531c400fc9538a90dcbec0b0d0eb71e0f926679c60251185f83c8d",
+				},
+				{
+					"0x000bfd491804c3b9d597c80b729cd1aaef8836f71cb630d9014a12c349e03f872b3f9647ea2cd361cbc749dda597dcc553981bd3b61cfb42be40c43131a6a61ac002d57b841ff8400e6e5b44bc42a71f379e4d7174a99caa6ab3e637646710b8",
+					"0x0110ec6218637d71faeb7a10cea75f2b7141ed92d51d41e7cc094e1387ffcbb587af082242a1ea22f566b9bdd1f0b1c4d4a708a5a1310d08cd73cf8396bb63dc2552579b89f0bbea9ad7d749c6ee8c20d8055ebc343678f5ea65a85aebd68e70",
+				},
+			},
+		},
+		{
+			msg: "abc",
+			p:   [2]string{
+				"0x001737fab1162caf67ff894a46d67f9427a02013c43c1f4c820534e0d104c579de870f857d9d98c7ec961e52cfdec2dcbe10f897d0c98ff2548a9ee13494cb861c4f72530d7b7881a8cc36e94335482f96880f568852f789be3dc0b0fb5bb6ca",
+				"0x0118cf71392bab82b6014de6d0dd73ecd0b06bb76f777c0a6e6d3622d362d56f46d4e35b636a342c7d3e14cb98c552d74721609a1b153658c2c6e985b3f4459fc9c234c926613e754a8eeb2ffcf285a6e49b2c2c47b40164de205c884d5e580b",
+			},
+			u: []string{
+				"0x00326442dc48d114d375f6014b8d65a3cf425115c2997a71239daa9209615a2746266ba78efd5b8f2112f17c8054555878dfe9d2a1af0c28adab664bb70dad22e75b5c9a54e50d8b107a305ef9ce007eed4becb1aa0b8aae20d81648e914b032",
+				"0x00f744360f5cc562fb714a788921f0411ab5883f4fff3af9e781c39de921e29407b0b99e669a271c8c705f5084b7c24c40dff436e0a5a587fe58a18ea1bcebe335f9a952251efed0508c4ba3550d99673bfba95518ded56fca70c87368163e65",
+			},
+			q: [][2]string{
+				{
+					"0x00281a7718e89a1bdfedb37aae153744c978ee21aa519f18f670ad48f171d069b7e4090ad1c2549af6b8c448d48ba3c189e1b440c63090c375cb1a257da0d48c9cb81862fd15e8a581cb0f95219e164061877dd70372e28f02f50da7d2196e24",
+					"0x00fc7845e4b75c5fa322bdddb1c454dd456f469612f4dfc39c031e53fa8b74d6d2798475db72564e89da0ecaf91353afb2583dd5482f726c91343105b8e6997f4eb859a1045253e9002aed1286cfb74996dcb971adcec9c5c8831d07c787eb5c",
+				},
+				{
+					"0x0036329284e92b609154fca14bc90caf0d7cb8ff8436a298924fe2cad340f2d51af0e26f64a22e0990012d38f36204322799f2d5942b9abe4ddb3b6f32b44ae1065cf645fa095f85aac07608c86aa4316edeb0aa452a217fc2628a153e61d653",
+					"0x005b470cf20c73dfa386e9df6956355b6ea9ff79e625ba552498f47004a2570cf4085462e5c12028755a1c6be95665bbf50c3ca4fcbe16e92fe8ae389b95a2d3766499bacf9c4a4a031a2359bbf3587927c7ba906cba86ad939804fc3f9d973b",
+				},
+			},
+		},
+		{
+			msg: "abcdef0123456789",
+			p:   [2]string{
+				"0x00561adbc9057922dd870af185e622e64f554f19243db1f68f2e490149839ee8ae69f59f3c1f1235b3ec32a7eb32534afadf23ae66e78e2c13b0c2245105bc2b869ba35df3ef9dd41a8c03b4977e4081dee06e3b2aff7d1eb232e6320ff21d7c",
+				"0x00e1a1774763a394f4965524065acc67a9d9611188157699b94545cc55fb907e9a417ac116808372fac3440558a2f4194f313b8c3278a8959eff7b4c3197348b83e2261e78f22673372ef2b9e08fd70f4f67fd74e8e2879943fbb8a33c18f583",
+			},
+			u: []string{
+				"0x00f3b0c7019d25a84a3a6c9442fe71e5ed7e34410524c3221b9350efd8c4029c1894caaf1562e3568d6f8ffc47112098f452c0a125de5324dbd7eccdbb16ae14c660a5043a572f741fa961e697ca2943de37d33cd861167b553f6f75bb03fea7",
+				"0x00afacfc04910ab448f653b4bd5be128ee07e1fd765c25e4830e0e8250ab948620ea7680530a4a0ef6f3ec0d1bd520f19e38b2df33083c8d708f872a531ff14eb0b1f48bd2b214d902ee1f401afd8650b206c52bfdc8cf7a8c3129aba9e658e0",
+			},
+			q: [][2]string{
+				{
+					"0x00e1cefbf22ae64f96059226af7a855352e625768d90a17af4303f5b0fb7b3ba074b45fe99c6a954c4cce75028409f2bc9191182514db4228d302d27e0ca02cce6f82aa1dab56448dbd90ebf982a93086f3799a7d3a93b5d8dd3fde99bfa691c",
+					"0x00defb5cb426285ff498acba5cb824d26e8d60a9b54b3c20cb6a713dd40563b5841afd860e828604d94b63e13b4b88180557598ea36c2e099e714fd7e3b910e824d0ed3fb1783b543e08d62c39a46b6bd27b6126c168ef248fc0da130da65289",
+				},
+				{
+					"0x0054d225473b790417ecff1e2f244db7aca85f596a8867e9b54b495294b65568d58d1e77d0d0b1d26520aeea68d710683847760c64bdd873fa02e5454eb3b6b25f049999c5ca1e14d2bf6db78c9803ce33db7dc4c3cbf52be444b17bf7907fa7",
+					"0x0039d17905ed6deb34fd08e0680064d0cb8e741ab84d8178215fab6d351b3f0bcb4059f7864bb23c2f31d3e47c100660e013d3db81ff74aee68231cf44bb68a5015bd92aaad0597a5db7204ed145ca7a1579e13990dc27c83c387bcc73c2beb0",
+				},
+			},
+		},
+		{
+			msg: "q128_qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq",
+			p:   [2]string{
+				"0x00dc08b37f046eea65e60b642e1375962785aa9e4c4babf5b9061d6f26b546cb1113d1827b1785589800303c0864145a41b5eeb488080f7cd626ffe49e2142e6f21b411ad9838e8b73f23f10db9e13d2164d88ed74a9e2e382ad5c5fd2595e08",
+				"0x00d44358a4d575cd265feba075dcc24c153ef6d47d177e5af868d8a02dee317952b51757ad9674afd9579da24196027dd2360ac1f0d08c3cfbff9318545b53f2dc88ecd89d436400b2259d7ace6b0a464d413b9ad56f38c523c6d19c8f95dfff",
+			},
+			u: []string{
+				"0x0066c61193cb5469965e3ba9807490e10c3195e1ff4c59a12d994aed1f85b3d242fa0b5381b1033b16b24d1b9ef7d17c5424c3ba33dedd2806718df0a7d1da72f910ded823855e09d81d53ae3df8f1442e7eeaa8a2e07ce9f237d12b5e593c58",
+				"0x000cd1cd154ec3e753b5f29cb4b485cc8fa3fbca7cab4a9c8794205449380f4c822ba9bf8ae58ea9f72b27a39465faab4ca96584d3cf950995e39222bd64cfc16734be8b1290fd7e354e185a0c6eb7371865c725f67b4b4e94840fe845ebf37e",
+			},
+			q: [][2]string{
+				{
+					"0x0055a63c4e71a24d8590a9d156d454b13e3cbbc2516a8b0c1309880cfc6d1d4aab0d696fbd170497de083fc5396677e864b656e6eb3885bc1b0079733c7f6f34600e0d6307c5ba29f1c5dbce5044273dd24d4d660d36010facf211b5a3b04aa0",
+					"0x00e8499eb00debbe436ac1924d37a743d8b41332d6d331f9c701294bacaa06825537645e115db79cf880d990d4f292064ca90828db80770ac13a91c8c7f590698ddea0dfdeadb4cbf4e6014a6cc585614ef75119cc963c9d686c7810c583d71a",
+				},
+				{
+					"0x0070a790d1e658fff9500a2cfb3d8cd7a458d67791ae35aa934950b748cfa29e15bb4f05abf410291359d258e39b89c13dfe6ad4435eb38097eae917eaf3c603170dd3c8ca5b701673ff3718482a9f937702e2563c8475aa3e16d10edf7e9416",
+					"0x00283d65b9a875be922bf700e7adec45461daeb009881be30ae0de5b44e8ddc54d6ddb4a8d32b4c0fe9edb066bd50c2559798122d3037f6bacc55c08ba0d592f28228bf8776c5bf9a51b27433a51df2008e22038a77c90ade29950b7869ccc5e",
+				},
+			},
+		},
+		{
+			msg: "a512_aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+			p:   [2]string{
+				"0x00c32c3f64dbed036a18b3e14f267f4e7ec134ced0668d0e4467da55983b6d85154ffb07ce2dca0848be56eec974bee5121a3fbf889e03ae5d10b7a7d5ecaa87fcacfe08ebf0e579317e42ac7f7723567f9ca2e71effb7859b7adb0dd3f1b96c",
+				"0x00fd2936c99e947cd229c590d230355777f401c58acd6d3dae029f8783773d7b0b7d3275cfe00d097789e104741c168bcc63d0ade9b977d77b27ab9c9fcbf159aa8c3573ca13b37c8d7966cb645884607e77ce9c4e010f9a5c96ae813c4f684f",
+			},
+			u: []string{
+				"0x00f33550f43cc52f537e871808a19c106aa8ac5444c31d5f61bae3d06626321b3cc80f9db98cfe813b3723ca25aee6bb24b98eafee8bfa4f44eca5bfd4d6aee5cba188512b71a2e5d1afbbaffd13d61872d516467746a0b8d9a50a6d7a05056d",
+				"0x00082fc38c03726b2821408fb9d0309453fed63414915935888b813722ec9ec872a3f91eea6f8bb06d23718ead53f2f1a0a87ccf21371f24e175b8457cd5fb99cca1e29f8e885fad21f13f14561d1e13d998ab38ff609bfa755d6efec6da17f4",
+			},
+			q: [][2]string{
+				{
+					"0x009c005d023f2881cc7381db4b28ed8af2f3f85c7804db25f470a9ecdd1cc36286b7ecbc0b0dee4e86562dce9de5155ddf5aab7c46cb86c193f53d29785787dd7166cf1c70727daa874b18a0f0e4f764b9e9bb9815e5f6bb1641a3832810239c",
+					"0x0118dd1d91cb0f2b638f1230e59747cb77586a2227fd8cb6793b5d2341fdb54eb1511085deea26ca9376e40c73ee87fa248772d1d14646a578b62f8f90dc4c604062a4078c5e453981bf543eaba50ae8550f70fc0a705775abe87274b381f253",
+				},
+				{
+					"0x009e8d32655baf7066ba652bd9e95eb97615351e07739ef57f17b520735fa4b78d274bbbd988eb1a5f3cf77a81ab23e6b7f2242c96b0082952b498575ce28dd0601e5a4825b3d3096c12e18b2769e2bcbe6393b581b1620e48d68f944ca359bb",
+					"0x000525e3df2799981fcb221534b311e138dac656b424dbcf5e43290f49815d9bbf63f45ae861808de6d831b8bde72d358be9eb9fe81715f38fecadff446a256025e213f86e7feee9fd16a91438d802adf212586ac18fc1a4bb3b8ca4ff72d150",
+				},
+			},
+		},
+	},
+}
+
+var encodeToCurveVectorsG1 = struct {
+	domain  string
+	vectors []hashToCurveVector
+}{
+	"QUUX-V01-CS02-with-BW6761G1_XMD:SHA-256_SVDW_NU_",
+	[]hashToCurveVector{
+		{
+			msg: "",
+			p:   [2]string{
+				"0x0025ba9bb81a7b448bfab96211e24b4ca3ac1cbcbb9a1ff66640025b28aa442f02d0f83f4eb96596634e866f997378c0ccf7ee59f37a0dd5601633c49a32d9325455acc3505207ed08c742531d53123b5719b8c6f7345c35f6ab4f41de649667",
+				"0x00c568117270e1a36ff39684ed365a6f0dccd7e742b4d89c68e06feacce679936c98bffb996a89d212f6dea45c6109014f8d522413c9f9bc0c92bb8c25ffd2b0928f83db5866f8396ae7666feee55d8f6ff07f2d2e15356e50e2ff9c5e4ce2ce",
+			},
+			u: []string{
+				"0x0117ca3f35033162c44502f35174c86f58215bad3ae0fa8573a66f46a72fd5302e6f4eb34ac6b9d8799394d52f492dcadc68c14d4240a2dae1e4e892402afe8ec3f1f16da0cb915d086bc0588a14802dc5b9ab53080b49cb3a3d0044b708e600",
+			},
+			q: [][2]string{
+				{
+					"0x00cdcf17e33248106087cc2a1d3f5ffc378fb5172fb49fd1af818958f559df428ff6fd73f0bfe062623d73118b191d08cc0e0f767eb9fc4f46cabb6995bac1dea22235dba50dc10019cdd8b8cd0da05d117c26b17afd85a73bc57796ba551cb5",
+					"0x0022675cf96f15a01deff2a99598517c39fb9dd568df11d3e0aff45fd48c0bb79d1326a932f652c642339b52c6639a7c52b9b260c353e5ed8e813da4c2a96efe4ea48b680cc4e93c66e189a7a61c0943c943ca3d6754b08f8a0b76286a072408",
+				},
+			},
+		},
+		{
+			msg: "abc",
+			p:   [2]string{
+				"0x0116d02ee6fa9a09c159fec0958486562911e77647fa8fd5907fda5c7bf1e12398adb9f6fb70ffb4108923c18b1b5fd16fe7aa091d62fde39f6333cce3f1c640741ab5a5fb9042fb1f3af36e2c0b642d95bd93caad49666eae1bdf112114fe25",
+				"0x01006b34054eaddc1a933cee38e8e2328c85c1c91aa68fc11573cfeda2a7f9c3b8c7b2b759352f5d97d83c0cd8e7e0c733a68b7d48d2b612adbba505e5afad9f8921998ce71c25c58df43aa37ef1620343b845f6e22a80e83ea44317382f8ad7",
+			},
+			u: []string{
+				"0x008bdfb702e2548c70a4d8c2307f321d70a426ef9e83e82226901cbc098317ce030b809d66f149843603aa9111ef91f11ab4440829b51bafb96e6b39b4a938e6d53dabd30005233a79cb626c7a2403baa78e0295bf8a324631a80fe36f9d839b",
+			},
+			q: [][2]string{
+				{
+					"0x00be5d37acc3b71b9623071345ced72b30937123519b07d0e6f41434c0052aacac9400ad73841185ba9480a022d13c0cdb17242b975750d0d4fe7715a110e62e84f296998f9302406c9ced21307202637f66c5e4273f1bbb14ad08c87eaaf1ca",
+					"0x00148dc609aafdfd2337374cff93d866ab0db9c9f0b62379e1adefbd55a5a1076f1898450379d8198c1e59587fc72fd94d734efae576660d2f212bd0989ad4137e06450b4586fae815c0037d4eea7a651e145632a7929ba1b65d3d91e889b533",
+				},
+			},
+		},
+		{
+			msg: "abcdef0123456789",
+			p:   [2]string{
+				"0x00a002307130a735eea82f64c0694745709b11ad785f4256dab97f3258a4e1daadd7ce5b0cc44eede60ffa9dfa1c7563ba61446502c68feff9b6f2c7c3c307a204622491c93230996851c02bdcfcc2a788348f00843898b9d52bafbb959022be",
+				"0x00645b81a902c7705e79819737ffa908d664bc46febf510f63237c9c309c19a7a3249bb35414c1559e28c2482c817e1f53910e6a42d6e1999ee77d02c58c8dc5c4fd345501fdb7e87b7addda21f943cfd6fb303216dcedefe25c9a283612d2c9",
+			},
+			u: []string{
+				"0x00b9fb90fe297d7796948da82c07e0f48ff958e4e74d786bc9e3d88b0f016b924aefc1132c4e7816b1932780b84d3e0507b135daec3c9fb022892ab6851cd7865e840cfa2fa5705912cb1555adce10cfebd94d43092df25821e405195bd9465d",
+			},
+			q: [][2]string{
+				{
+					"0x00c4da7b6550e82ba47f2a257e46ce9e5e2b00c62323d9dc9f6e7bd5f8a825d876abfbbbfe516432dd22c9aebf9f153d5e58225bdfb08da179834500ded38aeb421a5ac886becb9d95e7eb2286222a47c484c331580c9ceacfa7ea2cd6c9075d",
+					"0x00800f4e1d5589f99f3a853ea7a7508089545b402ea4cde9eb71eedbf61604145776cb3ac8dbf6a4d0bf793c688a62f386d1eab24d598def45a32046b0a88a4d212e8d905ea8e2807ad448aa52416c685e00a5b0894d1c31a0d6f230a9c7a513",
+				},
+			},
+		},
+		{
+			msg: "q128_qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq",
+			p:   [2]string{
+				"0x0017609701931bfa1a1947f4e77152cc50d1d71b211177230f3e76015a04f950d6a557818d44f3e3a60a9b793ac9a09e4bddbd4f8bb874ed9403b1dcae9ff04779ee557d8f7069ad010d97c9d51cc2b467be95e5eab11ef17b78af8218c367c4",
+				"0x005b079dff5ba9453b5c3a58c705fecad14b2591692dcf8d1396a82c2450082f3f2029ab4b5e8d7fe98c7c6e6b8cf31650b8721f4e5b289020b044d1687b58354c0a8a53b35baa04f1aa9cdbab708f0823a96b5bf4648a1f8d377d5de4235afd",
+			},
+			u: []string{
+				"0x0007417d43a9bf1696938e1eaf07766128fcd2d86d817feb1e3b42e8bbe2e0725a2bc97b717e2a20ba8f81e780b763091bb44a4f193e8b28ce183be016d417bfe462415f514539d1acd22d847f7b4759a85c2df5f727d19db2d9c7b23fc97bf7",
+			},
+			q: [][2]string{
+				{
+					"0x00e3d5deef82f81abfb6673fa1e356abf827521fd325d3971d47667f5cbb880d9bb3415fe6820b2a7392a378f4217b26be6376b73273daf36aa8ba009985567b2a7b9fc1639cc7313aa5313310faa90b58917c7e3c75105a9055a60575e1b1eb",
+					"0x01009f988464cb9274cd48e0c20ee980cf634ebf0ac78a2aaeb543cb49961f16200b510788a3b51626a25ca7cd1de1037ee72a8ee5aadf1adf1c4a488733dd365523f7fcacfe3f6af434c0fc14abef6533a10480681d11c4457ade4a7f014e25",
+				},
+			},
+		},
+		{
+			msg: "a512_aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+			p:   [2]string{
+				"0x0104468bcd124da30df164c58e689937382fc4b930938f9318c66c54ae9d96ace8bca894824ce015b2047863f78ad7964b2b0824da69bf0fa7bbb688aba4c08889301d65430f5d09d03b36b0966473275a7481d2be5339d0d2eef85dae246837",
+				"0x00d5e77da4ca7f2250c0f6a4b81b02f35f55d3911738cf9fecb84a087e798c0dfe20e9ceb4fe406c403e98026ad8baf5137ca7936a0e230ce0df03822d31ef8814866cee69cd94d9d7f3aa9ea3bcaef9499bd221118b4398be4958ec7c188631",
+			},
+			u: []string{
+				"0x00576e4c54f47e0334e6aec35885bfcfb6d318b6674266164c71db6ad1e9e732ab050cc2f6117aa74db5d13183dfe6407428be6dcdaf594567c45f204b70b8e7d69cd46df7dc2f19644f0d91b372f4f682258c17ff4b17e57f4626cff8cd2fd2",
+			},
+			q: [][2]string{
+				{
+					"0x00aa21039a8b922ea41cefc87226dbcb13624d3b68cd9b74f0fbf31f1f9687ed793fe2b4771f0a970f267e7a47cd0f361877a3f9252a69bfdb36ab4abb1af8d0afaf01aa953f652614d60a462c3b5f98bb8ae0a244d53d683649a5fda2d00940",
+					"0x00d812c409778d1cbd51917fb8e3ec68f06f714856ea6c91e7de828bda83d5fda4e97e7a000dac005c68150783cc3ad499f58cf00ab2f8e4bded5a334cc430c50cbd96491d67ffb17799a8ce2285d94a162856df3b1857130cf93df00477ad66",
+				},
+			},
+		},
+	},
+}
+
+var hashToCurveVectorsG2 = struct {
+	domain  string
+	vectors []hashToCurveVector
+}{
+	"QUUX-V01-CS02-with-BW6761G2_XMD:SHA-256_SVDW_RO_",
+	[]hashToCurveVector{
+		{
+			msg: "",
+			p:   [2]string{
+				"0x00d9960c8cc0755ca04b23a5e7a9b98aec86b9e30d478da4fd718986fafe008d48b6783ececbd636cbccad6ff9a216047c7d052c15265636d08b2fdab1fa7987e5b24253c93d74697876e8a1862b680ef7a6085a7efbf7a8710da1961c5667ef",
+				"0x00446fbccb698fcb5c6956db81c16506a8a24f9d7eb609eb926d8c8e1e83f4dd21399736b10cb042efc52bbce15e12176894769bbcd738447147db1c81cecc2f12dac37e5fe44404adafd4e3e6aa9210fa13543ca163d18c83b7cd5c35666011",
+			},
+			u: []string{
+				"0x000ccd19ca44c6a63d527ccf8975f2482f83cffd1d78cbf1938db0373c5f777e7beee92b2e9288c5408b48bdcda8597d528c31fdaf1bb2ff3fe0c2185ee42ec52094400211bdc694b47c0873d85e602ca04ddf286699bce312f492ead344e758",
+				"0x00a44b3eed8db472f3f24ecbda43e1111fc3db633a6acc81cfadb8f9bc42f1caa949dc764692c4c4e4a766208d5d151aa3a9328fb7cf19beeb52355ee3f4d124eff91ef4b2e82ab041ee600f998ea18aa6aef284088b15a9566e500d07ba52aa",
+			},
+			q: [][2]string{
+				{
+					"0x00fd856b166af16177c539e92be4c14cf49e783a25eefef101dc03cc1e5acf69311927471dfe3472b65b890e2f75ca6108cdde6f22253a44fdb6f37ff2d1f456fd652db616618e6e585a03a898cbed5b529a69cbf804a0200707f9e8620cf885",
+					"0x000befcb8c994ba6a2f33bf53b6becb165668b2f082ab6ca0c19fbedf803e9c8ff9e44bf1834bbabde63c11317fb6092069f02e18caa2f6cd7dbc2c0a205d8826705022f0a90cd760df1ae88422974aa0910186181005699a4b2dd437a63c71e",
+				},
+				{
+					"0x00478def32f92bd098521864cc084f7e51253cd210b27fe0d275bcb55192af6b06683bef5007e7e4101b2ad7192a53fa16d7859d21447b4f22214b93f16daba87cba2bdcead5c48135d36151bf408f0394b9f3cf8e42a802add143b70eae8534",
+					"0x00ea6693732e5f4e5ac2dc539c8206fa828d3d6d2a78b6c2c0a974f15c46fc9816f6feebc205b432dbe064acdcb4ef5409423b0893b95b5bd968c240cba6db1052e46fc80fc387e940e83c04116061bc13690b823ad7dad85d53bbaaf013a0fa",
+				},
+			},
+		},
+		{
+			msg: "abc",
+			p:   [2]string{
+				"0x00fda275a417d04aaea12d286b9d1e30a643b9de695b150df4f7abb43f52e0a6855bd0c5c41f98320465529fbf327b793ee8df8eaaffdd3b5b2f1a0a362eb0a2b8968c8faf5621633011804bbe5dec891c7e5e3141fb377bb0892fac40cec3fe",
+				"0x010d50b0f865f5afcb79db800893bc84c0b3abbdbef833a47d82f69e506044aa2f302e32c029cfe2ed632222e24544212d4b7a38ed4ea33c8f5fc0c49ececad128089b40aa9d5e7f9d72bf8583552677e6b1f11086cd2d0eba8710ee1275fa2f",
+			},
+			u: []string{
+				"0x003540ba64c318825902bb6ef08384daca7a627cc79985b6881cba2621bcb6cea516e45172bbcbd3e14773455e128258c44c4a266197fe6ea9670b73e84e1b4d3e09d95412ae3d74592bb387b7c8be39969d4d323f85722adec352243f4a5fbb",
+				"0x00b13f62ab21d66b5741d922db4884dbfc8dcd787c495bb729fdc628ed507241c5e0f761513bc67af78a017e199b323366eab28f3b77a73ccc040ec1c17ff5be9f9f1e25bcc1aa2d3254329daa2dabe9e1c1cba940825e7142adf7f961d86c51",
+			},
+			q: [][2]string{
+				{
+					"0x00050ed52ad84d0e206225452db08bcaa69cf31aede5a350d49f930a29aa6bdc81423f0d120698e3e8b8a7b30babe65721d71d7f2ef48b749a3046234a6f645b3d712d8d7233eb01a31b8570df7ba1f9057d4607c4ddf9f1a50f5286b7825091",
+					"0x00c7f889f0f065d7ea503c8a9a5ce97e9b46400d004baadf99ad2da673f57a530417a6b245eb021cd75fb3a762f1886f617dff1cfe52c6e8d60cf5b4330857224b07b50aee0c112a2ff9596efa2d5ed2f11ed3ce7ba12fd3c0743c87b39fcb95",
+				},
+				{
+					"0x007525eea839c0712c04c3c31f4173e5375cb0158c004f02afb64f679128c051ae2f6f6bfd162fda3df520563f8d3f5f1984b1a891595b644abfa863cba6a4627c3fbee138e166400a269d30ec945a7d78143c6b75ff8a6936e14558ca430728",
+					"0x00aa18cb150e761dcf29fe38eec69ea774afa3d7e1d9f3fbcb1b189a6ee4956480c0cd2c90bc7bcbc6395c45553e64c185975814910703e8d61a82e8d274730649b395a8f5467f98572d4afe472452945e8c073b123628c2af1411fd9affe78f",
+				},
+			},
+		},
+		{
+			msg: "abcdef0123456789",
+			p:   [2]string{
+				"0x010b3591449de65fb1e8833a1873cb8937058e469791a7ccba29f228c4f623292a8825f105fbeea39d33b479b0aaa81fde28b2c13b104815047898b77911f8929a7abdad8af6d8c9d5dac9b1c1526ba1a349b3397f48d35f6a4a9fc3f487ec21",
+				"0x0016fabf167d5309c543ee50891700589ab30ee6225e5839981ab19e7208807006a81cb0ec9ca44b7f6fa9661c2a87ff451419cef99bea78a723465d4162609491411b24087314a11129d0514a4230035de80db6ffb2aa3a86ca8c9398fa5212",
+			},
+			u: []string{
+				"0x00b692062949555de323db12fe4b4029b2afde86630db3b7b5657a874575efd8404428ad904b2d09b8da282bd96033087792c92914f46d03d3bf7842bbf8d4466c94a0c9a0618d6124f678701702c3c1bce1c4d27aee567b97bd3ea410ed62c1",
+				"0x011d4b3c10565f9d2e7368b6aa0eb21ebe2e0d2b0621799fe703e413c27d635fae145315e15554b3d6485d3e37bd4c6688b5dd8fe5a2e67281561b41e45ea2dd713eb16dc23ea0433803a0032e80b2fd4fd5260060cf041af964711e9f0c272e",
+			},
+			q: [][2]string{
+				{
+					"0x00f011a210798501f6d9c07d534d9762ed21fea2e0c62f556a57950274b23caf03823d50f964df7e0b543cba4ba48089fb10fafb1980f12e6c5ca771d8ffbe3ce3b30ac9077e7daa536ad1a30169906e50f30c70ab5adbd2c83acb8bb309f943",
+					"0x00371a52ace910fd450b1e7389169ecfad0cf9c11233096e63aad7f2fe17ca88851c5e4627ce132f395213abee11784e2e279617b3c2a2ef476272b6d90eb001150c19712a1c2a8f848933c190d740b0cee5d14ecbbc35b5fae89323199c14ed",
+				},
+				{
+					"0x00e5c23b584a50fe96bf9fd6488996d1a39114670034def84d14dd96031161158c5dc5b7d03249fae5365c81375dd9331fb51486574dda74fc440cb124c7eb868eb5f96a5f19d9b5410caab74e79f152480f175ba817930faf33b0fada6c2ace",
+					"0x0076bce27aaaf0f0eff02f51558c452475d55a867e610dd5bd9dccc5d2d8dec8d15f5880c1ed6bb4a3c12e08efed72f38695ff6f1ac92c59dcca6de7871b0351352d940a5af4f2c5c3020df0880c9fd37d882c70f6100edbb05b1eecd832d6d2",
+				},
+			},
+		},
+		{
+			msg: "q128_qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq",
+			p:   [2]string{
+				"0x003b172057d7492444f6fc15f11c3ef5d367d95fa4415e1f2fef1be6c04beea464c2ba7622ec8ce6cb7e820e485ec4fd1a3847db16d9efac853aa1f93a1af77332badb679df9d37632bc3ad9fa421feb63679380574ed653e04bf171512acd47",
+				"0x00324058d93554fe20eccfbe24522f38eba7c3494597f1c25feada6d23009e79fbeda02ef784d1a9785befb4fa2e701670f05cf373c847cefc128c8c19bbe159750dc2423d43b5b844d75161b3ad2973b2097a1020559f4b0134f9726728b657",
+			},
+			u: []string{
+				"0x002fd6be0fb76c162950888652d00d980f62ac65d342ecd488c545c7657d52b0674a295295002d01b07173bed43606a70ff2f545ee00bcd0aabfd8f9353e9dbc792a9244ae6e65b604f6416e29be40b4a5a64bee613852e66c50a0a389a96e20",
+				"0x005219bd3982bd5c4f723a80508e681d7aeeb45c83ed27163157411e710e34f8846a29a0775fdc8f02f4ede294d615a2246b9a3f47ae6999b0b3b384f49d3ad49f37c38c1448b7086b6e0c7016f61f1f15fecb1faea35fb383253c004f1f1c17",
+			},
+			q: [][2]string{
+				{
+					"0x0112b13feb013f07af875927727b5bbd0d50640f9ce70ffbed7acdbc06cfed20927e7505a43bc4aa7cc056d7e8c2e18c8d26e4d13885e927dc8c6e4ffaee8aa86e74820cf8d3e10b63bb7f3b6d6e501edab87cd67fcaea36b222a1b7005674a4",
+					"0x009b10cc1e60bea5eb8ec510986b0dc60a69b7f0989d376f5e9365ef05458494ec6e87390367b60553824540300bddbac75c0d4e04a197359933796bc9a7c15550eb3570a8f75207cb8cc8152492a76bd4246c6ed14a8319defe349e1e239058",
+				},
+				{
+					"0x011c866a87dc7b248ca15a4cd09978f947af9ae6f7081fb48847e1c40f87e31b7942ad0bf69453ffb7692c2da6fb3a2ac8e130db6d647bb63d824c099977f291a1aee4227b32685567d539ca2cdfb1d6d4d9511de972970478d66459fbcf9da5",
+					"0x001abfc77c8a1be3c1da7955fb59521ecf880eb106e9516b7e24a56ad1a7ba6d82a84735233e3f147ae180746f76a8166eadc6ac912bdfe8970cbe3dda72882b4793679f5810169200a9a1b476d6f01bc2eaf940a65307d333bb116aefaa6305",
+				},
+			},
+		},
+		{
+			msg: "a512_aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+			p:   [2]string{
+				"0x00dc515dc9e4c93eca4bbd84be1fc93db984ff546f29d8e8b45db208ad05e2c3430beb5e25e97750ec9a2382ba68c7928ba9b812a1b6c257151364bde3364e67f7944fef871a886dedc6367522f167f7491ab8c4e3d8ebfd87e56ea585c1e281",
+				"0x00b1336034b4dba6ad22cf04e5a80181b5e4d9f55c24cf4d9383c24c202ddad0be4f4a7600b751cf31d1801d96e28d6c2917b1191b11d866a36e35606a875aa353f8a44e7cb42596a80b121fecaa269c610a7ab453cf30a94bc3d4e087713dc2",
+			},
+			u: []string{
+				"0x00471bd64dedd56c3995e990d30b3ab9a9ca963f0aba8a353ccfc53ca6d6deaa662f3f73e0e09a636bfe153a80424b3a30594828fcd7cb151aea5d2b45f620ad3132d9aab97c450365137338ef17ab8b1bbe69a1e0afb5b23705a8561d354cac",
+				"0x008e04f45a5c1e22c446d31573ffe08c800db4cd0b17ab546e7500b7613a475419a99332aa4966b912746eda0f56369a7e5e31ed513571a6ef45fdb54ef4645a54451ad82159766a03c80b84498ccb43e3370998de3c9a77414357a565ba5a1b",
+			},
+			q: [][2]string{
+				{
+					"0x0121583a2298733ef9a867b82e0d9a7cb6742608f4256bf88b461d408d27a3bd39a03d3e6be98f91a0e20936458f5181b0879380162c0464d56b875cb531903990b64a663f1eefc080bcabea73362e7772c7e5bc1b7703464ce2562c36081709",
+					"0x010092ad3e5ecb5a26ddb4e0360edf8c1a6615fbe26e14e129a42feed55264d5bbe4e4ef002007566ed082521e2a420cda87e5a418f70ef3502ad7f81c93561f4b52f9c2a83e95854115f462bda3567589b2957a9b6db477ab0451c8195766ac",
+				},
+				{
+					"0x000c7c7ba23c3c66c5845ed30fac17fee619d8729bc6b708b78b30ac7044f81404ba66e35cdceae7b45d355c7e03aa013c2e9e22353717c10a08a21feb244cb9f68e0c3cbbfd2c5850fa0bb69703e72e6ace8a925ab7e5b336c2dd92d3cbc7fa",
+					"0x0055db2a8150c8785a3d35089570a8af7e860fd707d9d00286b073c803042cc49f10a7f40547a8d8bbee5fed3fc99d700f44567821f1a9a0eb54de045e49127287384c1e4a53d40bb18dc0484d6ac53eb05d0db10c79e919d31a9b96d48f3f13",
+				},
+			},
+		},
+	},
+}
+
+var encodeToCurveVectorsG2 = struct {
+	domain  string
+	vectors []hashToCurveVector
+}{
+	"QUUX-V01-CS02-with-BW6761G2_XMD:SHA-256_SVDW_NU_",
+	[]hashToCurveVector{
+		{
+			msg: "",
+			p:   [2]string{
+				"0x01209f67b3b18665590d21492bd523c529c6fa95e5318eec5c11d2c26e976c3c942253bcc23c746880095bfa50bb930245ca75002b590e712484c154d50af6752d1dd6d7d09f6f74036b61fc35b8355497dcc767a8868062d7ab35a578cfe276",
+				"0x00c3d356968609bc882629ddd8117c99352db770e0e706139eb26e6ee645a4462c5b257b1594173820cd1595e7c6504270f96ffea8f252141e66e91f31e9d57c9a1f1c21c37bdceedfd74a2b7bdb93d533d625e2ae7f4b42ee88be9e382bf434",
+			},
+			u: []string{
+				"0x004458a45d49d823861463df84f6c2058e9c4a70a50c1434aafbc2ea5d93f7c4e7b745e3ce0fb5806deb898b7acb439ce087ec126e3bdbfb1ed08381f1acf1cc145ecd5f17aeec4f0460f68328e8e43cb04a083804c3c8f046b64c3820c665e7",
+			},
+			q: [][2]string{
+				{
+					"0x011f0292c1f3a8c03e7120b15e8cdadeb0c8e90022ff0c49837ef4fd8736691154d32a1efc56a1089a111b21d9d1068caaa6a8bd4af8017e36ed988852b2d6d4a3ee434b99d7ef8cce641ceff0cd905e4d53a374b5f7c12060a7c097937c0837",
+					"0x00982b6810ecadde99a2f0a8c18d4c47759b8159aa217c291016f527075386b5147d29f8db555e01a2d0603a80ccdc0093580336601236925cb57e2b76ff4287ec22e1668ab961be326cf1316c68f5b0a9b8eb43157e3bdacda539dc76849895",
+				},
+			},
+		},
+		{
+			msg: "abc",
+			p:   [2]string{
+				"0x00f07437a336010dee09325c450dbf8caf9bd5783ea57b1a3aae330a14c704356d81ed1565524a3184a5fd76331aee70f58c8ba5df45b56ab28d4aeaf58ceed0742a80aef909d1728833771984a04cf03921a3b80f9f7e37bd3f812b6f6d5beb",
+				"0x011a942957205e3d8b0093e5deb818759de1afdd916401c713d1b1669c2443cb8079b95cb6e326aa8d14dde81c380c7fc65d39393b0221528f2de2ed0d85589a9c5f7adf390962ce4df9038fd8db9874464b7fae60adab3e65155e428c158287",
+			},
+			u: []string{
+				"0x0120e44eedbb6e61d3261b037ee8972e20c1d244f9e5b83c81a358ac88cb32c20a4b14302a1d312816cabd149e82761bb6d50c8b508ff1950f4aa854115f25aa8fcb8f67a78cc32f7880dd64f3f54c330e3174de52ab39f61ebc667bd402bcd2",
+			},
+			q: [][2]string{
+				{
+					"0x009b9d0f3d848b16e30ffe85cfc74886c52ac2dde577270420d0c802a87cfaabf4d2889f211da9ab11a6626fb203c0dac4d330673ed9f0bc3c66a621bb4604e635e0153b279dfe77c7d4a9ee1aa29cfd2f92916e4b5970246caceae376a5163e",
+					"0x000a40d450ebaddfab656f17e97488e85a9815b5a56b94781a718b1339957749fbaf9b584093e28817777de2b4c38ef1e6fce463dff3d6dd913935863d2fddce73b5a1bab95a90323efd6dfd4a9beda0db44d69aa9bf6941c787f44495034436",
+				},
+			},
+		},
+		{
+			msg: "abcdef0123456789",
+			p:   [2]string{
+				"0x00a04386a54ab1f8d9450c38e1721a04b311fb9161d310964c07a45dfc41312a8898e3c46a764e7427a5d18f0407c870e2cafb2c144110701a74fdf7ceb683d433f5a132cf9331a3cca9f96dbc1d6fae8af29fa5a327921d23cd6453976e6ccc",
+				"0x00db0787205f7ac8ecbd5ecdb7eb11abd9ab23e7018931eb86eb1fc231236190a12135dfb41c33e44fba6452f8b1e42cb8ce6a2f850f50ab2d96a488dfe77fa896a3ad80a92783be89dd8da6be7c380e2beca134e3150f006bce563ad93e8c16",
+			},
+			u: []string{
+				"0x00c2c201fa6117f04d5e58ccfeeeba7b1f77a7f396099d6d0f141699258c2b3dc322baec95bed94ca0b345af9c75d06bb76d70dd534562d5c24c7f519907525391507ec2f646c404d1901d511641211ecbc917eafa709d30d23b852798634bfc",
+			},
+			q: [][2]string{
+				{
+					"0x000be0c4dc38d2c10f8efd34460ac3b54474a8a3b4551f3ef99778c9de7a7a57ef1139de1dcb9f17314f69e2007cccc2ca8d9cdf437c77162583ea9d8bdac4c9325ec676ccdc68554740b335440835dcc109a87d4c19993585508097ee1ec4a7",
+					"0x010b161f1817c80deaa8e248f19ea809e13402064518446da0b1bad17f0f0a60699caef9df026e7a123d2f3185993d856d4d09f68322e687e0764c65fe6cacfeceb67e6aed022deda89fc57125bc15dd8c84cd7d646e85f125009630f494cae8",
+				},
+			},
+		},
+		{
+			msg: "q128_qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq",
+			p:   [2]string{
+				"0x001481e404c700e07c34ae5ba1de832ff932f373678ca20ac5d45c603287e5e6dca2bbbf34cab7924eb3d47fe7cf24a1c50f43737242a7b5df42ef8450196177ddbea2a896ba4167862186b9da8fae4d9ddb6688875aacb20333e4535fd3974b",
+				"0x00392fea121b9ebf069a597e6be28af8b59e62898ce161ba015e858866849dc3bd6e3c5fd691ad6953837683f477cf3237b38b2c7c8c78d50d9d4d4b1fc7ce04670422f05a119222431c507ef97a033afa0c284192d90376e5f23d9c2af84ab0",
+			},
+			u: []string{
+				"0x0063be7d7c3464be86078601b2066b7a4403428503193a333ea6f0ac33e9bc442e8a645deabc971c4acc61a9ab9dfefdb42b890225fcc753534604547c064e21fd64c5f80cdf10689d061264d382b10ab65454f90b6a83f78e6c71e723ab0510",
+			},
+			q: [][2]string{
+				{
+					"0x008f29241aae672439481d2c2e19e7fe3d6c8cb2b4ee91db0c1099e3f7e307dbd53098a250cf45acf88a8ea3fd27366cdd7c18874d2fdf069bb9c03921ac26b4c34b4f371dd5a397371f2901e9d6f31395f9e8a01024997bf7c6da2c68c2552e",
+					"0x00e2db5c918b62216a5189bce686d1dd4d0c907a64e9010e1f5942cc4ce331b5f9e9e06fc65254803bde1804e030133e56cd52624e0160ca51e09554b305c64fd7779d35563c340ac58c866e9ce54fa1c8976bfc4ebadbe841b202ab7d70fec8",
+				},
+			},
+		},
+		{
+			msg: "a512_aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+			p:   [2]string{
+				"0x00218de95a9188eb816d47f44ea5b8fd4da6a925be55492eaf5b565c92897b81170b83f30f7570188d3b1380fbd77d148f7041775912eb4168b967293ff5f99b53a880035daee63b87a5b5f3a648de2e0b580b3843a932e2ed995a7830cc9954",
+				"0x00a9c3204309eac514adfcf2be9ffbed0e72d445d4139b154d66c3297962c92ad9e914c9e140defaf7ccc0222d0059314b9bf902243544d246ecdd700f0acf70a7250c1f014f0d26b4ad5a053cc00d059ab540c2953c5a217a398fd179c2f893",
+			},
+			u: []string{
+				"0x0068c59aece8e361dba59806f4bd397b97d106e218be58a0955609affb00370aeebb72847ec04d55d6ba7dc8d04d6283e324d76e05cb5cf5917d8a04c652cc0bba2c031c1cd4862c61e5910a11bb9adb9e0dd92db9904aefda5e5bb12bd62076",
+			},
+			q: [][2]string{
+				{
+					"0x007c5feea4008c18da742fc56bb8099e3d825dcbb4d8d502c000d91836dbcb94623b09be9256fc856034ea1b6379369ccf046ef243452da3ffaf653d05ec7d28d836a6d045be538a5d6bf01cc30a193be72a485335d577d7f0e6af9d49a2081e",
+					"0x00532a4dbabbe1a357a6ce612eec56bcdedbbb8f33ebd9f33736249d7cf2ccca88dfff667075582471ba22f8785509d05f3615cf48fa993fe4422f43274df6ac5c2c35d2617ea40663bb44ecd8cf82d90ffe45d168a825edcfb1132dc538631e",
+				},
+			},
+		},
+	},
+}
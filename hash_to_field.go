@@ -0,0 +1,151 @@
+package bw6
+
+import (
+	"crypto/sha256"
+	"errors"
+	"math/big"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// Number of bytes that are reduced into a single field element
+// L = ceil((ceil(log2(p)) + k) / 8) where k = 128 is the target security level
+const (
+	hashToFieldSize = 112
+	hashToFrSize    = 64
+)
+
+// expandMsgXMD implements expand_message_xmd with SHA-256 as described in RFC 9380 section 5.3.1.
+func expandMsgXMD(msg, domain []byte, outLen int) ([]byte, error) {
+	const bInBytes, rInBytes = sha256.Size, sha256.BlockSize
+	if len(domain) > 255 {
+		h := sha256.New()
+		_, _ = h.Write([]byte("H2C-OVERSIZE-DST-"))
+		_, _ = h.Write(domain)
+		domain = h.Sum(nil)
+	}
+	ell := (outLen + bInBytes - 1) / bInBytes
+	if ell > 255 || outLen > 65535 {
+		return nil, errors.New("requested output length is too large")
+	}
+	domainPrime := append(append([]byte{}, domain...), byte(len(domain)))
+	// b_0 = H(Z_pad || msg || l_i_b_str || I2OSP(0, 1) || DST_prime)
+	h := sha256.New()
+	_, _ = h.Write(make([]byte, rInBytes))
+	_, _ = h.Write(msg)
+	_, _ = h.Write([]byte{byte(outLen >> 8), byte(outLen), 0})
+	_, _ = h.Write(domainPrime)
+	b0 := h.Sum(nil)
+	// b_1 = H(b_0 || I2OSP(1, 1) || DST_prime)
+	h.Reset()
+	_, _ = h.Write(b0)
+	_, _ = h.Write([]byte{1})
+	_, _ = h.Write(domainPrime)
+	bi := h.Sum(nil)
+	out := make([]byte, 0, ell*bInBytes)
+	out = append(out, bi...)
+	// b_i = H(strxor(b_0, b_(i - 1)) || I2OSP(i, 1) || DST_prime)
+	for i := 2; i <= ell; i++ {
+		for j := 0; j < bInBytes; j++ {
+			bi[j] ^= b0[j]
+		}
+		h.Reset()
+		_, _ = h.Write(bi)
+		_, _ = h.Write([]byte{byte(i)})
+		_, _ = h.Write(domainPrime)
+		bi = h.Sum(nil)
+		out = append(out, bi...)
+	}
+	return out[:outLen], nil
+}
+
+// expandMsgXOF implements expand_message_xof with SHAKE-128 as described in RFC 9380 section 5.3.2.
+func expandMsgXOF(msg, domain []byte, outLen int) ([]byte, error) {
+	if outLen > 65535 {
+		return nil, errors.New("requested output length is too large")
+	}
+	h := sha3.NewShake128()
+	if len(domain) > 255 {
+		_, _ = h.Write([]byte("H2C-OVERSIZE-DST-"))
+		_, _ = h.Write(domain)
+		domain = make([]byte, 32)
+		_, _ = h.Read(domain)
+		h.Reset()
+	}
+	// H(msg || I2OSP(len_in_bytes, 2) || DST || I2OSP(len(DST), 1), len_in_bytes)
+	_, _ = h.Write(msg)
+	_, _ = h.Write([]byte{byte(outLen >> 8), byte(outLen)})
+	_, _ = h.Write(domain)
+	_, _ = h.Write([]byte{byte(len(domain))})
+	out := make([]byte, outLen)
+	_, _ = h.Read(out)
+	return out, nil
+}
+
+type expander func(msg, domain []byte, outLen int) ([]byte, error)
+
+// hashToField implements hash_to_field of RFC 9380 section 5.2
+// and returns count big-endian integers each of size bytes reduced by the order.
+func hashToField(expand expander, msg, domain []byte, count, size int, order *big.Int) ([]*big.Int, error) {
+	if count < 1 {
+		return nil, errors.New("count must be positive")
+	}
+	uniformBytes, err := expand(msg, domain, count*size)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*big.Int, count)
+	for i := 0; i < count; i++ {
+		out[i] = new(big.Int).SetBytes(uniformBytes[i*size : (i+1)*size])
+		out[i].Mod(out[i], order)
+	}
+	return out, nil
+}
+
+func hashToFpWith(expand expander, msg, domain []byte, count int) ([]*fe, error) {
+	u, err := hashToField(expand, msg, domain, count, hashToFieldSize, modulus.big())
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*fe, count)
+	for i := 0; i < count; i++ {
+		out[i], _ = fromBig(u[i])
+	}
+	return out, nil
+}
+
+// HashToFp hashes the message to count base field elements with hash_to_field method of RFC 9380
+// using expand_message_xmd with SHA-256 where each element is reduced from 112 bytes.
+func HashToFp(msg, domain []byte, count int) ([]*Fe, error) {
+	return hashToFpWith(expandMsgXMD, msg, domain, count)
+}
+
+// HashToFpXOF hashes the message to count base field elements with hash_to_field method of RFC 9380
+// using expand_message_xof with SHAKE-128 where each element is reduced from 112 bytes.
+func HashToFpXOF(msg, domain []byte, count int) ([]*Fe, error) {
+	return hashToFpWith(expandMsgXOF, msg, domain, count)
+}
+
+func hashToFrWith(expand expander, msg, domain []byte, count int) ([]*Fr, error) {
+	u, err := hashToField(expand, msg, domain, count, hashToFrSize, q)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*Fr, count)
+	for i := 0; i < count; i++ {
+		out[i] = FrFromBig(u[i])
+	}
+	return out, nil
+}
+
+// HashToFr hashes the message to count scalar field elements with hash_to_field method of RFC 9380
+// using expand_message_xmd with SHA-256. Output elements are uniform and in canonical form.
+func HashToFr(msg, domain []byte, count int) ([]*Fr, error) {
+	return hashToFrWith(expandMsgXMD, msg, domain, count)
+}
+
+// HashToFrXOF hashes the message to count scalar field elements with hash_to_field method of RFC 9380
+// using expand_message_xof with SHAKE-128.
+func HashToFrXOF(msg, domain []byte, count int) ([]*Fr, error) {
+	return hashToFrWith(expandMsgXOF, msg, domain, count)
+}
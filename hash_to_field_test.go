@@ -0,0 +1,171 @@
+package bw6
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestExpandMsgXMD(t *testing.T) {
+	// RFC 9380 appendix K.1
+	domain := []byte("QUUX-V01-CS02-with-expander-SHA256-128")
+	longDomain := []byte("QUUX-V01-CS02-with-expander-SHA256-128-long-DST-" + strings.Repeat("1", 208))
+	for i, v := range []struct {
+		domain   []byte
+		msg      string
+		expected []byte
+	}{
+		{domain, "", fromHex(-1, "0x68a985b87eb6b46952128911f2a4412bbc302a9d759667f87f7a21d803f07235")},
+		{domain, "abc", fromHex(-1, "0xd8ccab23b5985ccea865c6c97b6e5b8350e794e603b4b97902f53a8a0d605615")},
+		{domain, "", fromHex(-1, "0xaf84c27ccfd45d41914fdff5df25293e221afc53d8ad2ac06d5e3e29485dadbee0d121587713a3e0dd4d5e69e93eb7cd4f5df4cd103e188cf60cb02edc3edf18eda8576c412b18ffb658e3dd6ec849469b979d444cf7b26911a08e63cf31f9dcc541708d3491184472c2c29bb749d4286b004ceb5ee6b9a7fa5b646c993f0ced")},
+		{longDomain, "", fromHex(-1, "0xe8dc0c8b686b7ef2074086fbdd2f30e3f8bfbd3bdf177f73f04b97ce618a3ed3")},
+	} {
+		out, err := expandMsgXMD([]byte(v.msg), v.domain, len(v.expected))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(out, v.expected) {
+			t.Fatalf("bad expansion at %d", i)
+		}
+	}
+	if _, err := expandMsgXMD(nil, domain, 255*32+1); err == nil {
+		t.Fatal("too large output length must be rejected")
+	}
+}
+
+func TestExpandMsgXOF(t *testing.T) {
+	// RFC 9380 appendix K.3
+	domain := []byte("QUUX-V01-CS02-with-expander-SHAKE128")
+	longDomain := []byte("QUUX-V01-CS02-with-expander-SHAKE128-long-DST-" + strings.Repeat("1", 210))
+	for i, v := range []struct {
+		domain   []byte
+		msg      string
+		expected []byte
+	}{
+		{domain, "", fromHex(-1, "0x86518c9cd86581486e9485aa74ab35ba150d1c75c88e26b7043e44e2acd735a2")},
+		{domain, "abc", fromHex(-1, "0x8696af52a4d862417c0763556073f47bc9b9ba43c99b505305cb1ec04a9ab468")},
+		{domain, "", fromHex(-1, "0x7314ff1a155a2fb99a0171dc71b89ab6e3b2b7d59e38e64419b8b6294d03ffee42491f11370261f436220ef787f8f76f5b26bdcd850071920ce023f3ac46847744f4612b8714db8f5db83205b2e625d95afd7d7b4d3094d3bdde815f52850bb41ead9822e08f22cf41d615a303b0d9dde73263c049a7b9898208003a739a2e57")},
+		{longDomain, "", fromHex(-1, "0x827c6216330a122352312bccc0c8d6e7a146c5257a776dbd9ad9d75cd880fc53")},
+	} {
+		out, err := expandMsgXOF([]byte(v.msg), v.domain, len(v.expected))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(out, v.expected) {
+			t.Fatalf("bad expansion at %d", i)
+		}
+	}
+	if _, err := expandMsgXOF(nil, domain, 65536); err == nil {
+		t.Fatal("too large output length must be rejected")
+	}
+}
+
+func TestHashToFr(t *testing.T) {
+	// vectors are generated offline with an independent implementation of hash_to_field
+	for _, vectors := range []struct {
+		hash     func(msg, domain []byte, count int) ([]*Fr, error)
+		domain   string
+		msg      []string
+		expected [][2]string
+	}{
+		{
+			HashToFr, "BW6761-V01-CS01-with-FR_XMD:SHA-256_",
+			[]string{"", "abc", "abcdef0123456789"},
+			[][2]string{
+				{"0x009b3d0a750748c9854f5230747d0a45c3d6ed93a6db4185b4bbc926270ce37a9c90e260ca3beec97ab084563242ea8a", "0x010d94c5098d638e1267bc65ee7e29b45a86e210ad031b4aba186d81e259165f6101019195cbe9fbf282b641073f9954"},
+				{"0x004c5cc41b81efa70db1f90e0b28a4f7b27ce913eaf3b289c580056ab09fd5ae64d7f31598e87c5f863e8ea12bef15d9", "0x00d25ef2379ae194b659acec6d78721dc94e3109ee89803224e1ab51bce09a8b7a2a713e55f914cbe3c41efec5429642"},
+				{"0x001bf4cb65d9e8ad3a992f2e3b70abccdc63b6e8898b4795d19df3307d77ee4ea883a104fe9c6dd45c15fd4dbfa5f307", "0x007439ae4bf22730f705c0d3392913a4e02f0032ac66be8e71bbf7bed3fc487c22fc0cd185b529922d33070c56c47302"},
+			},
+		},
+		{
+			HashToFrXOF, "BW6761-V01-CS01-with-FR_XOF:SHAKE-128_",
+			[]string{"", "abc", "abcdef0123456789"},
+			[][2]string{
+				{"0x019a99937db067840d8ba2743f70b5b7782258c13b07ff3daf2080be8cf35765b0b6bbba15c80829a62526af7804b4a7", "0x012f89dc48d3d7af134a0358cbb76bf92ad74d9f28e0bfa0450fb96f8389fba3c13dfe3d563deccb98dc7f486814977f"},
+				{"0x012140efbcc7a74135829b24e5153e2d003767d8038e2cbafcd462c1a4b63ac3ef6855a54bfebac7929be35396d8fb29", "0x0131b784ef7e3831ea5254d6c01c99598f7a301abb97b2fcd8cf43bea8098c1de641efc7f881070923424892f46804d2"},
+				{"0x0186502a38bc6b17dcb5df2de85065e429728ea4f6893c37f62636dc54f7a55b58cc5a1074969ea0936cbef2918c87b2", "0x0112d737f469522a09313348cb787c86c23216dbdd38cba490cda2ed526d22e68541024304e139ce66a2740b9a48ae39"},
+			},
+		},
+	} {
+		for i, msg := range vectors.msg {
+			u, err := vectors.hash([]byte(msg), []byte(vectors.domain), 2)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for j := 0; j < 2; j++ {
+				expected, err := FrFromBytes(fromHex(frByteSize, vectors.expected[i][j]))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !u[j].Equal(expected) {
+					t.Fatalf("hash to field failed, msg: %q", msg)
+				}
+			}
+		}
+		if _, err := vectors.hash(nil, []byte(vectors.domain), 0); err == nil {
+			t.Fatal("zero count must be rejected")
+		}
+	}
+}
+
+func TestHashToFp(t *testing.T) {
+	// vectors are generated offline with an independent implementation of hash_to_field
+	for _, vectors := range []struct {
+		hash     func(msg, domain []byte, count int) ([]*Fe, error)
+		domain   string
+		msg      []string
+		expected [][2]string
+	}{
+		{
+			HashToFp, "BW6761-V01-CS01-with-FP_XMD:SHA-256_",
+			[]string{"", "abc", "abcdef0123456789"},
+			[][2]string{
+				{
+					"0x002b5975004da2038888e17a9de7e50cc90a9c53b90f5b62106c384480ba350e9795411093db866bacc675648bd450447700ec2c8389bae6bb4a0a266f835903694349ef702035c474a494c4e6ece687d0902dea4b6b38442bf213caa86fbe64",
+					"0x00849e1717baa842453be9c9708b3416a005e423721933beb79c1db8ddfc62f872590ba3f9f58534d346be434c74a77c443b463974d1db8fd8539d111ea5007b061411c07c6382180b15b9ba23e36f9a4f7221f0cd016bec535840487c4584b8",
+				},
+				{
+					"0x0110e1444751d447f37fc82d269c9e08f40e186d16bebf0f9059634d7c0cef12c0cb6f1e558a23eb64f46d61df44763f1269d98c99b4fe10b447d72a8fc99bbe7d0fc7d3056ab4787d4ec1fb3a323ff55ba33673e98f7fe8890fb9b898aa406f",
+					"0x00f869d526f5f70b6308fa4c659dabca6ced3e7e3485b9c21aba0240ca4f901e003b324a3e7d41ccc03ac7c55230bde49bf5eec008d55c7a3cfbaba8f4b7814736e41c7ffa3fb646cf91195ee3c75d5a183fa55ac54de19ad1860d4d5b4d7336",
+				},
+				{
+					"0x0012cd3d850f126fa8f0b1c85e75a005ce408ccce74ea2755bbf411f197a383ff7070f18205040c915afe4decd74a24fd2bf44bb19e268b2d85341351ddc73d4502df74a73db54d5639c8c54ce0c2b7a61f155be3235090d4df4f4e7a89ba582",
+					"0x007a3276e47572c90c2b3735721e65b3c2f223e8564de6048c1200f164df0cbdf5180f9777f5be35aa92d92e3b7f7f19042d0d489d0f5660368e975324f6b6e72b891ebdfb5461388d23bc126f52bf210e53693187569ccfb503a48ebf819dd2",
+				},
+			},
+		},
+		{
+			HashToFpXOF, "BW6761-V01-CS01-with-FP_XOF:SHAKE-128_",
+			[]string{"", "abc", "abcdef0123456789"},
+			[][2]string{
+				{
+					"0x007c8c317c038a6dbdc64252cb5868ceeba38512803f6bbaa14cb0199e073b3e7f045403b46258fc5fe7c18f44393d423cda09c19dba7af3bdc44c76b30144eb5b1d2100f6707a8e9355b4fdfcd4451ed4bfbf40a5e759cd524798c26762556e",
+					"0x002f3e8465a9f170f9b04e77913f42ee3a569b89a34a64c424b896a1a13eb1031dba7d4c8e1773418d55ecf3fa543dc4075ff80187fad4aa18280051060774a3bb34c70004e575cca013e51170a5c5f8b8a8f6a65299955127f032b1461fbc64",
+				},
+				{
+					"0x00d0b366d9c6fcb14af8a3ad2e3fbfb944f42ea1e0b7eb62f698ea4dc13ade9bce22caee81b4534a3376cc938c8714ceb25885eb69a3507929829227c55689d430790aa687f7395549ffc3d037c88b30453ae75d6e35e04032811a169457a5ac",
+					"0x00770015fbe61c5e1ab7ab785a131d212977345a74be2c92cd60c30a0c76c746a83303959366189a4d99ee1a5ba5887797b385a05b316fcc7e9b946a470dd0a202bf09a1a8519a0c3f4d12781d180e831b0309ba935754c5f4e79ef27e528722",
+				},
+				{
+					"0x00b7d8a05fbdcbbb21d7248c74c75d46d6b4c04efb4b0b0a621d2b6d848b2b4a3197a3a6eecdcb8f8aefa4e151efc22ba420e9a5273b3fa9b2dbd8db12be4bb57cc1653c6a5bdc2c789b47fd0560f5fef206b1a525088c1714100a7fc22e797f",
+					"0x008bc598fbd95c1c7b11021a64a7c556a677686ac7aed310dfa152cee4564303fc23ae3378cbd9b7c9c6d84d74cf24f9ba299dda0bc5c89f999e62f82004140a610b72cb796395df7db29173f6b482048fb20c67fc23d4cd36dd253ecb34ac92",
+				},
+			},
+		},
+	} {
+		for i, msg := range vectors.msg {
+			u, err := vectors.hash([]byte(msg), []byte(vectors.domain), 2)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for j := 0; j < 2; j++ {
+				if toString(u[j]) != vectors.expected[i][j] {
+					t.Fatalf("hash to field failed, msg: %q", msg)
+				}
+			}
+		}
+		if _, err := vectors.hash(nil, []byte(vectors.domain), 0); err == nil {
+			t.Fatal("zero count must be rejected")
+		}
+	}
+}
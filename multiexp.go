@@ -0,0 +1,360 @@
+package bw6
+
+import (
+	"math"
+	"runtime"
+	"sync"
+)
+
+// multiExpBatchAffineThreshold is the minimum number of points where buckets are accumulated in affine form.
+var multiExpBatchAffineThreshold = 1 << 12
+
+// multiExpWindow returns the window size of bucket method for given number of points and scalar bit size.
+// Each window costs an addition per point and two additions per bucket while signed digits require
+// 2^(c - 1) buckets. Window size is bounded so that signed digits fit in 16 bits.
+func multiExpWindow(n, bitSize int) int {
+	c, cost := 2, math.Inf(1)
+	for w := 2; w < 16; w++ {
+		numWindows := bitSize/w + 1
+		if t := float64(numWindows) * float64(n+1<<w); t < cost {
+			c, cost = w, t
+		}
+	}
+	return c
+}
+
+// multiExp expects scalars in non Montgomery form
+func (g *group) multiExp(r *point, points []*point, scalars []Fr) *point {
+	return g.multiExpParallel(r, points, scalars, 1, false)
+}
+
+// multiExpParallel runs bucket method where windows and chunks of points are distributed across workers.
+// Each worker has its own group instance since groups carry scratch temps.
+// Non positive worker count defaults to GOMAXPROCS. Scalars are expected in non Montgomery form.
+// If glv is set scalars are split into two halves over P and φ(P) which is correct only for points
+// in correct subgroup where φ(P) = λP. Otherwise the result is exact for any point on curve.
+func (g *group) multiExpParallel(r *point, points []*point, scalars []Fr, workers int, glv bool) *point {
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	bitSize := frBitSize
+	if glv {
+		points, scalars, bitSize = g.glvSplit(points, scalars)
+	}
+	n := len(points)
+	c := multiExpWindow(n, bitSize)
+	numWindows := bitSize/c + 1
+	digits := signedDigits(scalars, c, numWindows)
+	// points are split into chunks only if there are not enough windows to keep workers busy
+	chunks := 1
+	if workers > numWindows {
+		chunks = (workers + numWindows - 1) / numWindows
+	}
+	chunkSize := (n + chunks - 1) / chunks
+	batchAffine := n >= multiExpBatchAffineThreshold
+	if batchAffine {
+		points = g.affinePoints(points)
+	}
+	sums := make([]point, numWindows*chunks)
+	jobs := make(chan int, len(sums))
+	for i := 0; i < len(sums); i++ {
+		jobs <- i
+	}
+	close(jobs)
+	if workers > len(sums) {
+		workers = len(sums)
+	}
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			gw := &group{newTempG(), g.b, g.glvPhi, g.cofactor}
+			bucket := make([]point, 1<<(c-1))
+			var batch *batchAffineAccumulator
+			if batchAffine {
+				batch = newBatchAffineAccumulator(gw, bucket)
+			}
+			for job := range jobs {
+				j, k := job/chunks, job%chunks
+				from, to := k*chunkSize, (k+1)*chunkSize
+				if to > n {
+					to = n
+				}
+				if from > to {
+					from = to
+				}
+				d := digits[j*n+from : j*n+to]
+				if batchAffine {
+					batch.bucketSum(&sums[job], points[from:to], d)
+				} else {
+					gw.bucketSum(&sums[job], bucket, points[from:to], d)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	acc := new(point).zero()
+	for j := numWindows - 1; j >= 0; j-- {
+		for i := 0; i < c; i++ {
+			g.double(acc, acc)
+		}
+		for k := 0; k < chunks; k++ {
+			g.add(acc, acc, &sums[j*chunks+k])
+		}
+	}
+	return r.set(acc)
+}
+
+// signedDigits recodes scalars into c bit signed digits in [-2^(c - 1), 2^(c - 1)]
+// so that only 2^(c - 1) buckets are required. Digits are ordered by window first.
+// The most significant window absorbs the final carry and stays non negative.
+func signedDigits(scalars []Fr, c, numWindows int) []int16 {
+	n := len(scalars)
+	h := 1 << (c - 1)
+	digits := make([]int16, n*numWindows)
+	for i := 0; i < n; i++ {
+		carry := 0
+		for j := 0; j < numWindows; j++ {
+			d := scalars[i].window(j*c, c) + carry
+			carry = 0
+			if d >= h && j != numWindows-1 {
+				d -= 1 << c
+				carry = 1
+			}
+			digits[j*n+i] = int16(d)
+		}
+	}
+	return digits
+}
+
+// glvSplit decomposes each scalar k into k1 + k2 * λ and returns points (±P, ±φ(P))
+// with absolute values of the halves together with the bit size of the largest half.
+func (g *group) glvSplit(points []*point, scalars []Fr) ([]*point, []Fr, int) {
+	n := len(points)
+	outPoints := make([]point, 2*n)
+	outScalars := make([]Fr, 2*n)
+	bitSize := 0
+	for i := 0; i < n; i++ {
+		v := new(glvVector).new(scalars[i].big())
+		p1, p2 := &outPoints[2*i], &outPoints[2*i+1]
+		p1.set(points[i])
+		p2.set(points[i])
+		mul(&p2[0], &p2[0], g.glvPhi) // φ(P) in jacobian coordinates
+		if v.k1.Sign() < 0 {
+			g.neg(p1, p1)
+			v.k1.Neg(v.k1)
+		}
+		if v.k2.Sign() < 0 {
+			g.neg(p2, p2)
+			v.k2.Neg(v.k2)
+		}
+		outScalars[2*i].setBig(v.k1)
+		outScalars[2*i+1].setBig(v.k2)
+		if v.k1.BitLen() > bitSize {
+			bitSize = v.k1.BitLen()
+		}
+		if v.k2.BitLen() > bitSize {
+			bitSize = v.k2.BitLen()
+		}
+	}
+	out := make([]*point, 2*n)
+	for i := 0; i < 2*n; i++ {
+		out[i] = &outPoints[i]
+	}
+	return out, outScalars, bitSize
+}
+
+// bucketSum calculates sum of points weighted by signed digits of a single window.
+func (g *group) bucketSum(r *point, bucket []point, points []*point, digits []int16) *point {
+	for i := 0; i < len(bucket); i++ {
+		bucket[i].zero()
+	}
+	for i := 0; i < len(digits); i++ {
+		d := digits[i]
+		if d > 0 {
+			g.add(&bucket[d-1], &bucket[d-1], points[i])
+		} else if d < 0 {
+			g.sub(&bucket[-d-1], &bucket[-d-1], points[i])
+		}
+	}
+	acc, sum := new(point).zero(), new(point).zero()
+	for i := len(bucket) - 1; i >= 0; i-- {
+		g.add(sum, sum, &bucket[i])
+		g.add(acc, acc, sum)
+	}
+	return r.set(acc)
+}
+
+// affinePoints returns the input if all points are in affine form, otherwise a normalized copy.
+func (g *group) affinePoints(points []*point) []*point {
+	normalized := true
+	for i := 0; i < len(points); i++ {
+		if !points[i].isAffine() && !g.isZero(points[i]) {
+			normalized = false
+			break
+		}
+	}
+	if normalized {
+		return points
+	}
+	out := make([]*point, len(points))
+	for i := 0; i < len(points); i++ {
+		out[i] = new(point).set(points[i])
+	}
+	g.affineBatch(out)
+	return out
+}
+
+// batchAffineAccumulator keeps buckets in affine form and adds points into buckets in batches
+// so that a single inversion is shared across a batch. A bucket can take part in a batch only once,
+// colliding additions go to a bucket in jacobian form that is merged at the end.
+type batchAffineAccumulator struct {
+	g            *group
+	bucket       []point
+	overflow     []point
+	negated      point
+	inBatch      []bool
+	batchBucket  []int
+	batchPoint   []*point
+	batchNeg     []bool
+	denominators []fe
+}
+
+func newBatchAffineAccumulator(g *group, bucket []point) *batchAffineAccumulator {
+	batchSize := len(bucket) / 8
+	if batchSize < 1 {
+		batchSize = 1
+	}
+	return &batchAffineAccumulator{
+		g:            g,
+		bucket:       bucket,
+		overflow:     make([]point, len(bucket)),
+		inBatch:      make([]bool, len(bucket)),
+		batchBucket:  make([]int, 0, batchSize),
+		batchPoint:   make([]*point, 0, batchSize),
+		batchNeg:     make([]bool, 0, batchSize),
+		denominators: make([]fe, batchSize),
+	}
+}
+
+// bucketSum calculates the same sum as group.bucketSum expecting points in affine form.
+func (b *batchAffineAccumulator) bucketSum(r *point, points []*point, digits []int16) *point {
+	for i := 0; i < len(b.bucket); i++ {
+		b.bucket[i].zero()
+		b.overflow[i].zero()
+	}
+	for i := 0; i < len(digits); i++ {
+		d := digits[i]
+		if d == 0 || b.g.isZero(points[i]) {
+			continue
+		}
+		if d > 0 {
+			b.add(int(d)-1, points[i], false)
+		} else {
+			b.add(int(-d)-1, points[i], true)
+		}
+	}
+	b.flush()
+	acc, sum := new(point).zero(), new(point).zero()
+	for i := len(b.bucket) - 1; i >= 0; i-- {
+		b.g.addMixed(sum, sum, &b.bucket[i])
+		if !b.g.isZero(&b.overflow[i]) {
+			b.g.add(sum, sum, &b.overflow[i])
+		}
+		b.g.add(acc, acc, sum)
+	}
+	return r.set(acc)
+}
+
+// add queues addition of the point or its negation into the bucket at index i.
+func (b *batchAffineAccumulator) add(i int, p *point, negate bool) {
+	if b.g.isZero(&b.bucket[i]) {
+		b.bucket[i].set(p)
+		if negate {
+			neg(&b.bucket[i][1], &p[1])
+		}
+		return
+	}
+	if b.inBatch[i] {
+		if negate {
+			p = b.g.neg(&b.negated, p)
+		}
+		b.g.addMixed(&b.overflow[i], &b.overflow[i], p)
+		return
+	}
+	b.inBatch[i] = true
+	b.batchBucket = append(b.batchBucket, i)
+	b.batchPoint = append(b.batchPoint, p)
+	b.batchNeg = append(b.batchNeg, negate)
+	if len(b.batchBucket) == cap(b.batchBucket) {
+		b.flush()
+	}
+}
+
+// flush adds queued points to their buckets in affine coordinates with a shared batch inversion.
+func (b *batchAffineAccumulator) flush() {
+	n := len(b.batchBucket)
+	if n == 0 {
+		return
+	}
+	t0, t1, lambda, y := new(fe), new(fe), new(fe), new(fe)
+	d := b.denominators[:n]
+	for k := 0; k < n; k++ {
+		a, p := &b.bucket[b.batchBucket[k]], b.batchPoint[k]
+		if a[0].equal(&p[0]) {
+			b.y(y, k)
+			neg(t0, y)
+			if a[1].equal(t0) {
+				// a = -p
+				d[k].zero()
+			} else {
+				// a = p
+				double(&d[k], &a[1])
+			}
+		} else {
+			sub(&d[k], &p[0], &a[0])
+		}
+	}
+	inverseBatch(d)
+	for k := 0; k < n; k++ {
+		i := b.batchBucket[k]
+		a, p := &b.bucket[i], b.batchPoint[k]
+		b.inBatch[i] = false
+		if d[k].isZero() {
+			a.zero()
+			continue
+		}
+		if a[0].equal(&p[0]) {
+			// lambda = 3x^2 / 2y
+			square(t0, &a[0])
+			double(t1, t0)
+			add(t0, t0, t1)
+		} else {
+			// lambda = (y2 - y1) / (x2 - x1)
+			b.y(y, k)
+			sub(t0, y, &a[1])
+		}
+		mul(lambda, t0, &d[k])
+		square(t0, lambda)
+		sub(t0, t0, &a[0])
+		sub(t0, t0, &p[0])
+		sub(t1, &a[0], t0)
+		mul(t1, t1, lambda)
+		sub(&a[1], t1, &a[1])
+		a[0].set(t0)
+	}
+	b.batchBucket = b.batchBucket[:0]
+	b.batchPoint = b.batchPoint[:0]
+	b.batchNeg = b.batchNeg[:0]
+}
+
+// y sets r to y coordinate of the queued point at index k taking the negation into account.
+func (b *batchAffineAccumulator) y(r *fe, k int) {
+	if b.batchNeg[k] {
+		neg(r, &b.batchPoint[k][1])
+		return
+	}
+	r.set(&b.batchPoint[k][1])
+}
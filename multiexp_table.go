@@ -0,0 +1,331 @@
+package bw6
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"runtime"
+	"sync"
+)
+
+// multiExpTable keeps shifted multiples 2^(c * j) * P_i of bases in affine form ordered by window first
+// so that the point at index j * n + i pairs with the signed digit of the scalar i at window j.
+// Multi exponentiation then reduces to a single bucket pass over all windows without doublings.
+type multiExpTable struct {
+	window int
+	n      int
+	points []point
+	ptrs   []*point
+}
+
+func multiExpTableNumberOfWindows(window int) int {
+	return frBitSize/window + 1
+}
+
+// multiExpTableWindow returns the window size for given number of bases where a single pass
+// costs an addition per base and window and two additions per bucket.
+func multiExpTableWindow(n int) int {
+	c, cost := 2, math.Inf(1)
+	for w := 2; w < 16; w++ {
+		if t := float64(multiExpTableNumberOfWindows(w))*float64(n) + float64(int(1)<<w); t < cost {
+			c, cost = w, t
+		}
+	}
+	return c
+}
+
+func newMultiExpTable(window, n int, points []point) *multiExpTable {
+	ptrs := make([]*point, len(points))
+	for i := 0; i < len(points); i++ {
+		ptrs[i] = &points[i]
+	}
+	return &multiExpTable{window, n, points, ptrs}
+}
+
+// checkMultiExpTableWindow accepts windows in [2, 15] and non positive windows
+// which are replaced with the one chosen by the number of bases.
+func checkMultiExpTableWindow(window int) error {
+	if window == 1 || window > 15 {
+		return errors.New("window size must be in [2, 15]")
+	}
+	return nil
+}
+
+func (g *group) newMultiExpTable(bases []*point, window int) *multiExpTable {
+	n := len(bases)
+	c := window
+	if c < 1 {
+		c = multiExpTableWindow(n)
+	}
+	numWindows := multiExpTableNumberOfWindows(c)
+	points := make([]point, n*numWindows)
+	for i := 0; i < n; i++ {
+		base := new(point).set(bases[i])
+		for j := 0; j < numWindows; j++ {
+			points[j*n+i].set(base)
+			for k := 0; k < c; k++ {
+				g.double(base, base)
+			}
+		}
+	}
+	t := newMultiExpTable(c, n, points)
+	g.affineBatch(t.ptrs)
+	return t
+}
+
+// multiExpTableMul expects at most n scalars in non Montgomery form and less than q.
+// Missing scalars are taken as zero.
+func (g *group) multiExpTableMul(r *point, t *multiExpTable, scalars []Fr, workers int) *point {
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	s := make([]Fr, t.n)
+	copy(s, scalars)
+	digits := signedDigits(s, t.window, multiExpTableNumberOfWindows(t.window))
+	n := len(t.points)
+	if workers > n {
+		workers = n
+	}
+	if workers < 1 {
+		return r.zero()
+	}
+	chunkSize := (n + workers - 1) / workers
+	batchAffine := n >= multiExpBatchAffineThreshold
+	sums := make([]point, workers)
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func(w int) {
+			defer wg.Done()
+			from, to := w*chunkSize, (w+1)*chunkSize
+			if to > n {
+				to = n
+			}
+			if from > to {
+				from = to
+			}
+			gw := &group{newTempG(), g.b, g.glvPhi, g.cofactor}
+			bucket := make([]point, 1<<(t.window-1))
+			if batchAffine {
+				newBatchAffineAccumulator(gw, bucket).bucketSum(&sums[w], t.ptrs[from:to], digits[from:to])
+			} else {
+				gw.bucketSum(&sums[w], bucket, t.ptrs[from:to], digits[from:to])
+			}
+		}(w)
+	}
+	wg.Wait()
+	acc := new(point).zero()
+	for w := 0; w < workers; w++ {
+		g.add(acc, acc, &sums[w])
+	}
+	return r.set(acc)
+}
+
+// multiExpTableToBytes encodes the window size in a single byte followed by table points in uncompressed form.
+func (g *group) multiExpTableToBytes(t *multiExpTable) []byte {
+	out := make([]byte, 1, 1+len(t.points)*2*fpByteSize)
+	out[0] = byte(t.window)
+	for i := 0; i < len(t.points); i++ {
+		out = append(out, g.toBytes(&t.points[i])...)
+	}
+	return out
+}
+
+// multiExpTableFromBytes decodes a table and checks that all points are on curve.
+// Number of bases is derived from the input length.
+func (g *group) multiExpTableFromBytes(in []byte) (*multiExpTable, error) {
+	if len(in) < 1 {
+		return nil, errors.New("input string must not be empty")
+	}
+	window := int(in[0])
+	if window < 2 || window > 15 {
+		return nil, errors.New("window size must be in [2, 15]")
+	}
+	rowSize := multiExpTableNumberOfWindows(window) * 2 * fpByteSize
+	if (len(in)-1)%rowSize != 0 {
+		return nil, errors.New("input string length does not match with window size")
+	}
+	n := (len(in) - 1) / rowSize
+	points := make([]point, (len(in)-1)/(2*fpByteSize))
+	for i := 0; i < len(points); i++ {
+		p, err := g.fromUncompressed(in[1+i*2*fpByteSize : 1+(i+1)*2*fpByteSize])
+		if err != nil {
+			return nil, err
+		}
+		points[i].set(p)
+	}
+	return newMultiExpTable(window, n, points), nil
+}
+
+func (g *group) multiExpTableBig(r *point, t *multiExpTable, scalars []*big.Int) (*point, error) {
+	if err := t.checkInput(len(scalars), func(i int) bool { return scalars[i] == nil }); err != nil {
+		return nil, err
+	}
+	s := make([]Fr, len(scalars))
+	for i := 0; i < len(scalars); i++ {
+		s[i].Set(frFromBigReduced(scalars[i]))
+	}
+	return g.multiExpTableMul(r, t, s, 1), nil
+}
+
+func (g *group) multiExpTableFr(r *point, t *multiExpTable, scalars []*Fr, workers int) (*point, error) {
+	if err := t.checkInput(len(scalars), func(i int) bool { return scalars[i] == nil }); err != nil {
+		return nil, err
+	}
+	s := make([]Fr, len(scalars))
+	for i := 0; i < len(scalars); i++ {
+		fromMontFR(&s[i], scalars[i])
+	}
+	return g.multiExpTableMul(r, t, s, workers), nil
+}
+
+// checkInput checks the number of scalars and nil scalars as multi exponentiation without a table
+// where the first n scalars pair with the first n bases at the lowest window.
+func (t *multiExpTable) checkInput(n int, isNilScalar func(i int) bool) error {
+	if n > t.n {
+		return ErrLengthMismatch
+	}
+	return checkMultiExpInput(t.ptrs[:n], n, isNilScalar)
+}
+
+func checkMultiExpBases(points []*point) error {
+	return checkMultiExpInput(points, len(points), func(int) bool { return false })
+}
+
+// MultiExpTableG1 keeps precomputed shifted multiples of G1 bases for repeated multi exponentiations
+// over the same bases such as commitments with a fixed reference string.
+// Table keeps 377 / c + 1 points per base for window size c where a point takes 296 bytes in memory
+// and 192 bytes serialized. Window chosen for 2^20 bases is 15 which is 26 points per base
+// and about 7.5 GiB in memory, smaller windows trade memory for slower multi exponentiation.
+type MultiExpTableG1 struct {
+	g     *G1
+	table *multiExpTable
+}
+
+// NewMultiExpTable precomputes a table for multi exponentiations over given G1 bases.
+// Bases are expected to be in correct subgroup. Window size is expected to be in [2, 15]
+// and bounds the size of the table, if window is not positive it is chosen by the number of bases.
+func (g *G1) NewMultiExpTable(points []*PointG1, window int) (*MultiExpTableG1, error) {
+	if err := checkMultiExpTableWindow(window); err != nil {
+		return nil, err
+	}
+	p := pointsG1(points)
+	if err := checkMultiExpBases(p); err != nil {
+		return nil, err
+	}
+	return &MultiExpTableG1{g, g.newMultiExpTable(p, window)}, nil
+}
+
+// MultiExpTableFromBytes decodes a precomputed table that is serialized with ToBytes.
+// Input is expected to be from a trusted source since only the curve equation is checked,
+// so a table of points that are not in correct subgroup is accepted.
+func (g *G1) MultiExpTableFromBytes(in []byte) (*MultiExpTableG1, error) {
+	t, err := g.multiExpTableFromBytes(in)
+	if err != nil {
+		return nil, err
+	}
+	return &MultiExpTableG1{g, t}, nil
+}
+
+// ToBytes serializes the precomputed table.
+func (t *MultiExpTableG1) ToBytes() []byte {
+	return t.g.multiExpTableToBytes(t.table)
+}
+
+// Len returns the number of bases.
+func (t *MultiExpTableG1) Len() int {
+	return t.table.n
+}
+
+// MultiExp calculates e_0 * P_0 + e_1 * P_1 + ... + e_n * P_n over the bases of the table.
+// Scalars are reduced modulo q. Fewer scalars than bases are allowed and missing ones are taken as zero,
+// otherwise ErrLengthMismatch is returned. Result is assigned to point at first argument.
+func (t *MultiExpTableG1) MultiExp(r *PointG1, scalars []*big.Int) (*PointG1, error) {
+	if _, err := t.g.multiExpTableBig((*point)(r), t.table, scalars); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// MultiExpFr calculates multi exponentiation as MultiExp where scalars are given as scalar field elements.
+func (t *MultiExpTableG1) MultiExpFr(r *PointG1, scalars []*Fr) (*PointG1, error) {
+	return t.MultiExpParallel(r, scalars, 1)
+}
+
+// MultiExpParallel calculates multi exponentiation as MultiExpFr where table points are distributed
+// across given number of goroutines. If workers is not positive GOMAXPROCS is used.
+func (t *MultiExpTableG1) MultiExpParallel(r *PointG1, scalars []*Fr, workers int) (*PointG1, error) {
+	if _, err := t.g.multiExpTableFr((*point)(r), t.table, scalars, workers); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// MultiExpTableG2 keeps precomputed shifted multiples of G2 bases for repeated multi exponentiations
+// over the same bases such as commitments with a fixed reference string.
+// Table keeps 377 / c + 1 points per base for window size c where a point takes 296 bytes in memory
+// and 192 bytes serialized. Window chosen for 2^20 bases is 15 which is 26 points per base
+// and about 7.5 GiB in memory, smaller windows trade memory for slower multi exponentiation.
+type MultiExpTableG2 struct {
+	g     *G2
+	table *multiExpTable
+}
+
+// NewMultiExpTable precomputes a table for multi exponentiations over given G2 bases.
+// Bases are expected to be in correct subgroup. Window size is expected to be in [2, 15]
+// and bounds the size of the table, if window is not positive it is chosen by the number of bases.
+func (g *G2) NewMultiExpTable(points []*PointG2, window int) (*MultiExpTableG2, error) {
+	if err := checkMultiExpTableWindow(window); err != nil {
+		return nil, err
+	}
+	p := pointsG2(points)
+	if err := checkMultiExpBases(p); err != nil {
+		return nil, err
+	}
+	return &MultiExpTableG2{g, g.newMultiExpTable(p, window)}, nil
+}
+
+// MultiExpTableFromBytes decodes a precomputed table that is serialized with ToBytes.
+// Input is expected to be from a trusted source since only the curve equation is checked,
+// so a table of points that are not in correct subgroup is accepted.
+func (g *G2) MultiExpTableFromBytes(in []byte) (*MultiExpTableG2, error) {
+	t, err := g.multiExpTableFromBytes(in)
+	if err != nil {
+		return nil, err
+	}
+	return &MultiExpTableG2{g, t}, nil
+}
+
+// ToBytes serializes the precomputed table.
+func (t *MultiExpTableG2) ToBytes() []byte {
+	return t.g.multiExpTableToBytes(t.table)
+}
+
+// Len returns the number of bases.
+func (t *MultiExpTableG2) Len() int {
+	return t.table.n
+}
+
+// MultiExp calculates e_0 * P_0 + e_1 * P_1 + ... + e_n * P_n over the bases of the table.
+// Scalars are reduced modulo q. Fewer scalars than bases are allowed and missing ones are taken as zero,
+// otherwise ErrLengthMismatch is returned. Result is assigned to point at first argument.
+func (t *MultiExpTableG2) MultiExp(r *PointG2, scalars []*big.Int) (*PointG2, error) {
+	if _, err := t.g.multiExpTableBig((*point)(r), t.table, scalars); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// MultiExpFr calculates multi exponentiation as MultiExp where scalars are given as scalar field elements.
+func (t *MultiExpTableG2) MultiExpFr(r *PointG2, scalars []*Fr) (*PointG2, error) {
+	return t.MultiExpParallel(r, scalars, 1)
+}
+
+// MultiExpParallel calculates multi exponentiation as MultiExpFr where table points are distributed
+// across given number of goroutines. If workers is not positive GOMAXPROCS is used.
+func (t *MultiExpTableG2) MultiExpParallel(r *PointG2, scalars []*Fr, workers int) (*PointG2, error) {
+	if _, err := t.g.multiExpTableFr((*point)(r), t.table, scalars, workers); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
@@ -0,0 +1,201 @@
+package bw6
+
+import (
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func TestMultiExpTableG1(t *testing.T) {
+	defer func(threshold int) { multiExpBatchAffineThreshold = threshold }(multiExpBatchAffineThreshold)
+	g := NewG1()
+	for _, n := range []int{0, 1, 5, 100} {
+		bases := make([]*PointG1, n)
+		for i := 0; i < n; i++ {
+			bases[i] = g.randCorrect()
+		}
+		if n > 2 {
+			bases[1].Zero()
+		}
+		table, err := g.NewMultiExpTable(bases, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if table.Len() != n {
+			t.Fatal("bad table size")
+		}
+		decoded, err := g.MultiExpTableFromBytes(table.ToBytes())
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, m := range []int{0, n / 2, n} {
+			scalars := make([]*big.Int, m)
+			scalarsFr := make([]*Fr, m)
+			for i := 0; i < m; i++ {
+				scalars[i] = randScalar(new(big.Int).Lsh(q, 1))
+				if i%3 == 0 {
+					scalars[i].Neg(scalars[i])
+				}
+				scalarsFr[i] = FrFromBig(scalars[i])
+			}
+			expected := g.New()
+			if _, err := g.MultiExp(expected, bases[:m], scalars); err != nil {
+				t.Fatal(err)
+			}
+			for _, threshold := range []int{1 << 30, 0} {
+				multiExpBatchAffineThreshold = threshold
+				r0, r1, r2, r3 := g.New(), g.New(), g.New(), g.New()
+				if _, err := table.MultiExp(r0, scalars); err != nil {
+					t.Fatal(err)
+				}
+				if _, err := table.MultiExpFr(r1, scalarsFr); err != nil {
+					t.Fatal(err)
+				}
+				if _, err := table.MultiExpParallel(r2, scalarsFr, 3); err != nil {
+					t.Fatal(err)
+				}
+				if _, err := decoded.MultiExpFr(r3, scalarsFr); err != nil {
+					t.Fatal(err)
+				}
+				if !g.Equal(expected, r0) || !g.Equal(expected, r1) || !g.Equal(expected, r2) || !g.Equal(expected, r3) {
+					t.Fatal("multi exponentiation with precomputed table failed", n, m, threshold)
+				}
+			}
+		}
+	}
+}
+
+func TestMultiExpTableG2(t *testing.T) {
+	g := NewG2()
+	n := 20
+	bases := make([]*PointG2, n)
+	scalars := make([]*Fr, n)
+	for i := 0; i < n; i++ {
+		bases[i] = g.randCorrect()
+		var err error
+		if scalars[i], err = new(Fr).Rand(rand.Reader); err != nil {
+			t.Fatal(err)
+		}
+	}
+	table, err := g.NewMultiExpTable(bases, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := g.MultiExpTableFromBytes(table.ToBytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, r0, r1 := g.New(), g.New(), g.New()
+	if _, err := g.MultiExpFr(expected, bases, scalars); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := table.MultiExpFr(r0, scalars); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := decoded.MultiExpParallel(r1, scalars, 0); err != nil {
+		t.Fatal(err)
+	}
+	if !g.Equal(expected, r0) || !g.Equal(expected, r1) {
+		t.Fatal("multi exponentiation with precomputed table failed")
+	}
+}
+
+func TestMultiExpTableInput(t *testing.T) {
+	g := NewG1()
+	if _, err := g.NewMultiExpTable([]*PointG1{g.One(), nil}, 0); !errors.Is(err, ErrNilPoint) {
+		t.Fatal("nil base must be rejected", err)
+	}
+	table, err := g.NewMultiExpTable([]*PointG1{g.One(), g.One()}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := table.MultiExpFr(g.New(), []*Fr{new(Fr), new(Fr), new(Fr)}); !errors.Is(err, ErrLengthMismatch) {
+		t.Fatal("more scalars than bases must be rejected", err)
+	}
+	if _, err := table.MultiExp(g.New(), []*big.Int{big.NewInt(1), nil}); !errors.Is(err, ErrNilScalar) {
+		t.Fatal("nil scalar must be rejected", err)
+	}
+	in := table.ToBytes()
+	for _, bad := range [][]byte{{}, {1}, {16}, in[:len(in)-1]} {
+		if _, err := g.MultiExpTableFromBytes(bad); err == nil {
+			t.Fatal("bad input must be rejected")
+		}
+	}
+	in[len(in)-1] ^= 1
+	if _, err := g.MultiExpTableFromBytes(in); err == nil {
+		t.Fatal("point not on curve must be rejected")
+	}
+	for _, window := range []int{1, 16} {
+		if _, err := g.NewMultiExpTable([]*PointG1{g.One()}, window); err == nil {
+			t.Fatal("bad window size must be rejected", window)
+		}
+	}
+	bases := []*PointG1{g.randCorrect(), g.randCorrect(), g.randCorrect()}
+	scalars := []*Fr{FrFromBig(randScalar(q)), FrFromBig(randScalar(q)), FrFromBig(randScalar(q))}
+	expected := g.New()
+	if _, err := g.MultiExpFr(expected, bases, scalars); err != nil {
+		t.Fatal(err)
+	}
+	for _, window := range []int{2, 7, 15} {
+		table, err := g.NewMultiExpTable(bases, window)
+		if err != nil {
+			t.Fatal(err)
+		}
+		in := table.ToBytes()
+		if int(in[0]) != window || len(in) != 1+len(bases)*multiExpTableNumberOfWindows(window)*2*fpByteSize {
+			t.Fatal("table is not built with given window size", window)
+		}
+		r := g.New()
+		if _, err := table.MultiExpFr(r, scalars); err != nil {
+			t.Fatal(err)
+		}
+		if !g.Equal(expected, r) {
+			t.Fatal("multi exponentiation with given window size failed", window)
+		}
+	}
+	// only the curve equation is checked
+	table, err = g.NewMultiExpTable([]*PointG1{g.rand()}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := g.MultiExpTableFromBytes(table.ToBytes()); err != nil {
+		t.Fatal("table of points out of subgroup must be accepted", err)
+	}
+}
+
+func BenchmarkMultiExpTable(t *testing.B) {
+	g := NewG1()
+	n := 1 << 12
+	bases := make([]*PointG1, n)
+	scalars := make([]*Fr, n)
+	for i := 0; i < n; i++ {
+		bases[i] = g.randAffine()
+		var err error
+		if scalars[i], err = new(Fr).Rand(rand.Reader); err != nil {
+			t.Fatal(err)
+		}
+	}
+	t.Run("MultiExpFr", func(t *testing.B) {
+		result := g.New()
+		t.ResetTimer()
+		for i := 0; i < t.N; i++ {
+			if _, err := g.MultiExpFr(result, bases, scalars); err != nil {
+				t.Fatal(err)
+			}
+		}
+	})
+	t.Run("Table", func(t *testing.B) {
+		table, err := g.NewMultiExpTable(bases, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		result := g.New()
+		t.ResetTimer()
+		for i := 0; i < t.N; i++ {
+			if _, err := table.MultiExpFr(result, scalars); err != nil {
+				t.Fatal(err)
+			}
+		}
+	})
+}
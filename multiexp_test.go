@@ -0,0 +1,230 @@
+package bw6
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"testing"
+)
+
+func TestMultiExpParallel(t *testing.T) {
+	g := NewG1()
+	for _, n := range []int{0, 1, 3, 100, 1 << 10} {
+		bases := make([]*PointG1, n)
+		scalars := make([]*Fr, n)
+		for i := 0; i < n; i++ {
+			bases[i] = g.randAffine()
+			scalars[i], _ = new(Fr).Rand(rand.Reader)
+		}
+		expected := g.New()
+		_, _ = g.MultiExpFr(expected, bases, scalars)
+		for _, workers := range []int{-1, 0, 1, 2, 7, 64, 300} {
+			result := g.New()
+			_, err := g.MultiExpParallel(result, bases, scalars, workers)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !g.Equal(expected, result) {
+				t.Fatal("parallel multi-exponentiation failed", n, workers)
+			}
+		}
+	}
+	if _, err := g.MultiExpParallel(g.New(), []*PointG1{g.One()}, []*Fr{}, 0); err == nil {
+		t.Fatal("length mismatch must be rejected")
+	}
+}
+
+func TestMultiExpBatchAffine(t *testing.T) {
+	defer func(threshold int) { multiExpBatchAffineThreshold = threshold }(multiExpBatchAffineThreshold)
+	g := NewG1()
+	for _, n := range []int{1, 2, 3, 100, 1 << 10} {
+		bases := make([]*PointG1, n)
+		scalars := make([]*Fr, n)
+		for i := 0; i < n; i++ {
+			bases[i] = g.randAffine()
+			scalars[i], _ = new(Fr).Rand(rand.Reader)
+		}
+		// exceptional cases where a bucket is added to itself, to its negation or to zero
+		// and points are not in affine form
+		for i := 0; i+4 < n; i += 5 {
+			bases[i+1].Set(bases[i])
+			g.Neg(bases[i+2], bases[i])
+			bases[i+3].Zero()
+			g.Double(bases[i+4], bases[i+4])
+			scalars[i+1].Set(scalars[i])
+			scalars[i+2].Set(scalars[i])
+		}
+		multiExpBatchAffineThreshold = n + 1
+		expected := g.New()
+		_, _ = g.MultiExpFr(expected, bases, scalars)
+		multiExpBatchAffineThreshold = 0
+		for _, workers := range []int{1, 3} {
+			result := g.New()
+			_, _ = g.MultiExpParallel(result, bases, scalars, workers)
+			if !g.Equal(expected, result) {
+				t.Fatal("batch affine multi-exponentiation failed", n, workers)
+			}
+		}
+	}
+	// all points fall into the same buckets
+	n := 1 << 10
+	bases := make([]*PointG1, n)
+	scalars := make([]*Fr, n)
+	e, _ := new(Fr).Rand(rand.Reader)
+	for i := 0; i < n; i++ {
+		bases[i] = g.randAffine()
+		scalars[i] = e
+	}
+	multiExpBatchAffineThreshold = 1 << 30
+	expected := g.New()
+	_, _ = g.MultiExpFr(expected, bases, scalars)
+	multiExpBatchAffineThreshold = 0
+	result := g.New()
+	_, _ = g.MultiExpFr(result, bases, scalars)
+	if !g.Equal(expected, result) {
+		t.Fatal("batch affine multi-exponentiation failed with colliding buckets")
+	}
+}
+
+func TestSignedDigits(t *testing.T) {
+	for _, c := range []int{3, 8, 13, 16} {
+		numWindows := frBitSize/c + 1
+		scalars := make([]Fr, 20)
+		for i := 0; i < len(scalars); i++ {
+			e, _ := new(Fr).Rand(rand.Reader)
+			fromMontFR(&scalars[i], e)
+		}
+		scalars[0].Zero()
+		fromMontFR(&scalars[1], new(Fr).Neg(frR1))
+		digits := signedDigits(scalars, c, numWindows)
+		n := len(scalars)
+		for i := 0; i < n; i++ {
+			acc := new(big.Int)
+			for j := numWindows - 1; j >= 0; j-- {
+				d := int(digits[j*n+i])
+				if d < -(1<<(c-1)) || d > 1<<(c-1) {
+					t.Fatal("digit out of range", c, d)
+				}
+				acc.Lsh(acc, uint(c))
+				acc.Add(acc, big.NewInt(int64(d)))
+			}
+			if acc.Cmp(scalars[i].big()) != 0 {
+				t.Fatal("bad recoding", c)
+			}
+		}
+	}
+}
+
+func TestMultiExpGLV(t *testing.T) {
+	defer func(threshold int) { multiExpBatchAffineThreshold = threshold }(multiExpBatchAffineThreshold)
+	g1, g2 := NewG1(), NewG2()
+	for _, n := range []int{1, 2, 50, 300} {
+		bases1, bases2 := make([]*PointG1, n), make([]*PointG2, n)
+		scalars := make([]*Fr, n)
+		expected1, expected2 := g1.Zero(), g2.Zero()
+		for i := 0; i < n; i++ {
+			bases1[i], bases2[i] = g1.randAffine(), g2.randAffine()
+			scalars[i], _ = new(Fr).Rand(rand.Reader)
+			if i%7 == 3 {
+				// full size scalar to exercise the top window carry
+				scalars[i].Neg(frR1)
+			}
+			g1.Add(expected1, expected1, g1.MulScalarFr(g1.New(), bases1[i], scalars[i]))
+			g2.Add(expected2, expected2, g2.MulScalarFr(g2.New(), bases2[i], scalars[i]))
+		}
+		for _, glv := range []bool{false, true} {
+			for _, threshold := range []int{1 << 30, 0} {
+				multiExpBatchAffineThreshold = threshold
+				r1, r2 := g1.New(), g2.New()
+				var err1, err2 error
+				if glv {
+					_, err1 = g1.MultiExpGLV(r1, bases1, scalars, 2)
+					_, err2 = g2.MultiExpGLV(r2, bases2, scalars, 2)
+				} else {
+					_, err1 = g1.MultiExpParallel(r1, bases1, scalars, 2)
+					_, err2 = g2.MultiExpParallel(r2, bases2, scalars, 2)
+				}
+				if err1 != nil || err2 != nil {
+					t.Fatal(err1, err2)
+				}
+				if !g1.Equal(expected1, r1) {
+					t.Fatal("G1 multi-exponentiation failed", n, glv, threshold)
+				}
+				if !g2.Equal(expected2, r2) {
+					t.Fatal("G2 multi-exponentiation failed", n, glv, threshold)
+				}
+			}
+		}
+	}
+}
+
+func TestMultiExpOutOfSubgroup(t *testing.T) {
+	defer func(threshold int) { multiExpBatchAffineThreshold = threshold }(multiExpBatchAffineThreshold)
+	g1, g2 := NewG1(), NewG2()
+	n := 40
+	bases1, bases2 := make([]*PointG1, n), make([]*PointG2, n)
+	scalars := make([]*Fr, n)
+	scalarsBig := make([]*big.Int, n)
+	expected1, expected2 := g1.Zero(), g2.Zero()
+	for i := 0; i < n; i++ {
+		bases1[i], bases2[i] = g1.rand(), g2.rand()
+		scalars[i], _ = new(Fr).Rand(rand.Reader)
+		scalarsBig[i] = scalars[i].ToBig()
+		// naive double and add is exact for any point on curve
+		g1.Add(expected1, expected1, (*PointG1)(g1.mulScalar(new(point), (*point)(bases1[i]), scalarsBig[i])))
+		g2.Add(expected2, expected2, (*PointG2)(g2.mulScalar(new(point), (*point)(bases2[i]), scalarsBig[i])))
+	}
+	if g1.InCorrectSubgroup(bases1[0]) || g2.InCorrectSubgroup(bases2[0]) {
+		t.Fatal("points are expected to be out of correct subgroup")
+	}
+	for _, threshold := range []int{1 << 30, 0} {
+		multiExpBatchAffineThreshold = threshold
+		r1, r2 := g1.New(), g2.New()
+		if _, err := g1.MultiExpFr(r1, bases1, scalars); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := g2.MultiExpParallel(r2, bases2, scalars, 3); err != nil {
+			t.Fatal(err)
+		}
+		if !g1.Equal(expected1, r1) || !g2.Equal(expected2, r2) {
+			t.Fatal("multi exponentiation must be exact for points out of correct subgroup", threshold)
+		}
+		if _, err := g1.MultiExp(r1, bases1, scalarsBig); err != nil {
+			t.Fatal(err)
+		}
+		if !g1.Equal(expected1, r1) {
+			t.Fatal("multi exponentiation must be exact for points out of correct subgroup", threshold)
+		}
+	}
+}
+
+func BenchmarkMultiExpParallel(t *testing.B) {
+	g := NewG1()
+	n := 1 << 14
+	bases := make([]*PointG1, n)
+	scalars := make([]*Fr, n)
+	for i := 0; i < n; i++ {
+		bases[i] = g.randAffine()
+		scalars[i], _ = new(Fr).Rand(rand.Reader)
+	}
+	for _, threshold := range []int{n + 1, n} {
+		multiExpBatchAffineThreshold = threshold
+		t.Run(fmt.Sprint("batch affine: ", threshold <= n), func(t *testing.B) {
+			result := g.New()
+			t.ResetTimer()
+			for i := 0; i < t.N; i++ {
+				_, _ = g.MultiExpParallel(result, bases, scalars, 1)
+			}
+		})
+	}
+	multiExpBatchAffineThreshold = 1 << 12
+	for _, workers := range []int{1, 2, 4, 8, 16} {
+		t.Run(fmt.Sprint(workers), func(t *testing.B) {
+			result := g.New()
+			t.ResetTimer()
+			for i := 0; i < t.N; i++ {
+				_, _ = g.MultiExpParallel(result, bases, scalars, workers)
+			}
+		})
+	}
+}
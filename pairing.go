@@ -1,16 +1,17 @@
 package bw6
 
 type pair struct {
-	g1 *Point
-	g2 *Point
+	g1 *PointG1
+	g2 *PointG2
 }
 
-func newPair(g1 *Point, g2 *Point) pair {
+func newPair(g1 *PointG1, g2 *PointG2) pair {
 	return pair{g1, g2}
 }
 
 type Engine struct {
-	g   *G
+	g1  *G1
+	g2  *G2
 	fp6 *fp6
 	fp3 *fp3
 	pairingEngineTemp
@@ -24,7 +25,8 @@ func NewEngine() *Engine {
 	return &Engine{
 		fp6:               fp6,
 		fp3:               fp3,
-		g:                 NewG(),
+		g1:                NewG1(),
+		g2:                NewG2(),
 		pairingEngineTemp: newEngineTemp(),
 	}
 }
@@ -43,7 +45,7 @@ func newEngineTemp() pairingEngineTemp {
 	return pairingEngineTemp{t, t6}
 }
 
-func (e *Engine) doublingStep(coeff *[3]fe, r *Point) {
+func (e *Engine) doublingStep(coeff *[3]fe, r *PointG2) {
 
 	t := e.t
 
@@ -79,7 +81,7 @@ func (e *Engine) doublingStep(coeff *[3]fe, r *Point) {
 	neg(&coeff[2], t[8])
 }
 
-func (e *Engine) additionStep(coeff *[3]fe, r, q *Point) {
+func (e *Engine) additionStep(coeff *[3]fe, r, q *PointG2) {
 
 	t := e.t
 
@@ -108,18 +110,18 @@ func (e *Engine) additionStep(coeff *[3]fe, r, q *Point) {
 	coeff[2].set(t[2])
 }
 
-func (e *Engine) ell(f *fe6, coeffs *[3]fe, p *Point) {
+func (e *Engine) ell(f *fe6, coeffs *[3]fe, p *PointG1) {
 	c0, c1, c2 := new(fe).set(&coeffs[0]), new(fe), new(fe)
 	mul(c1, &coeffs[1], &p[0])
 	mul(c2, &coeffs[2], &p[1])
 	e.fp6.mulBy014Assign(f, c0, c1, c2)
 }
 
-func (e *Engine) preCompute(ellCoeffs *[288][3]fe, twistPoint *Point) {
-	if e.g.IsZero(twistPoint) {
+func (e *Engine) preCompute(ellCoeffs *[288][3]fe, twistPoint *PointG2) {
+	if e.g2.IsZero(twistPoint) {
 		return
 	}
-	r := new(Point).Set(twistPoint)
+	r := new(PointG2).Set(twistPoint)
 	j := 0
 
 	for i := ateLoop1.BitLen() - 2; i >= 0; i-- {
@@ -133,7 +135,7 @@ func (e *Engine) preCompute(ellCoeffs *[288][3]fe, twistPoint *Point) {
 	}
 
 	r.Set(twistPoint)
-	negTwist := e.g.Neg(e.g.New(), twistPoint)
+	negTwist := e.g2.Neg(e.g2.New(), twistPoint)
 	for i := 188; i >= 0; i-- {
 		e.doublingStep(&ellCoeffs[j], r)
 		j++
@@ -345,8 +347,8 @@ func (e *Engine) finalExp(f *fe6) {
 }
 
 func (e *Engine) affine(p pair) {
-	e.g.Affine(p.g1)
-	e.g.Affine(p.g2)
+	e.g1.Affine(p.g1)
+	e.g2.Affine(p.g2)
 }
 
 func (e *Engine) calculate() *fe6 {
@@ -360,7 +362,7 @@ func (e *Engine) calculate() *fe6 {
 }
 
 // AddPair adds a g1, g2 point pair to pairing engine
-func (e *Engine) AddPair(g1 *Point, g2 *Point) *Engine {
+func (e *Engine) AddPair(g1 *PointG1, g2 *PointG2) *Engine {
 	p := newPair(g1, g2)
 	if !e.isZero(p) {
 		e.affine(p)
@@ -370,9 +372,9 @@ func (e *Engine) AddPair(g1 *Point, g2 *Point) *Engine {
 }
 
 // AddPairInv adds a G1, G2 point pair to pairing engine. G1 point is negated.
-func (e *Engine) AddPairInv(g1 *Point, g2 *Point) *Engine {
-	ng1 := e.g.New().Set(g1)
-	e.g.Neg(ng1, g1)
+func (e *Engine) AddPairInv(g1 *PointG1, g2 *PointG2) *Engine {
+	ng1 := e.g1.New().Set(g1)
+	e.g1.Neg(ng1, g1)
 	e.AddPair(ng1, g2)
 	return e
 }
@@ -384,7 +386,7 @@ func (e *Engine) Reset() *Engine {
 }
 
 func (e *Engine) isZero(p pair) bool {
-	return e.g.IsZero(p.g1) || e.g.IsZero(p.g2)
+	return e.g1.IsZero(p.g1) || e.g2.IsZero(p.g2)
 }
 
 // Result computes pairing and returns target group element as result.
@@ -394,6 +396,16 @@ func (e *Engine) Result() *E {
 	return r
 }
 
+// G1 returns G1 group instance.
+func (e *Engine) G1() *G1 {
+	return NewG1()
+}
+
+// G2 returns G2 group instance.
+func (e *Engine) G2() *G2 {
+	return NewG2()
+}
+
 // GT returns target group instance.
 func (e *Engine) GT() *GT {
 	return NewGT()
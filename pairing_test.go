@@ -22,7 +22,7 @@ func TestPairingExpected(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	r := bw6.AddPair(bw6.g.G1One(), bw6.g.G2One()).Result()
+	r := bw6.AddPair(bw6.g1.One(), bw6.g2.One()).Result()
 	if !r.Equal(expected) {
 		t.Fatalf("expected pairing failed")
 	}
@@ -33,7 +33,7 @@ func TestPairingExpected(t *testing.T) {
 
 func TestPairingNonDegeneracy(t *testing.T) {
 	bw6 := NewEngine()
-	g1Zero, g2Zero, g1One, g2One := bw6.g.Zero(), bw6.g.Zero(), bw6.g.G1One(), bw6.g.G2One()
+	g1Zero, g2Zero, g1One, g2One := bw6.g1.Zero(), bw6.g2.Zero(), bw6.g1.One(), bw6.g2.One()
 	GT := bw6.GT()
 	// e(g1^a, g2^b) != 1
 	bw6.Reset()
@@ -86,11 +86,11 @@ func TestPairingBilinearity(t *testing.T) {
 	{
 		a, b := big.NewInt(17), big.NewInt(117)
 		c := new(big.Int).Mul(a, b)
-		G1, G2 := bw6.g.G1One(), bw6.g.G2One()
+		G1, G2 := bw6.g1.One(), bw6.g2.One()
 		e0 := bw6.AddPair(G1, G2).Result()
-		P1, P2 := bw6.g.new(), bw6.g.new()
-		bw6.g.MulScalarG1(P1, G1, a)
-		bw6.g.MulScalarG2(P2, G2, b)
+		P1, P2 := bw6.g1.New(), bw6.g2.New()
+		bw6.g1.MulScalar(P1, G1, a)
+		bw6.g2.MulScalar(P2, G2, b)
 		e1 := bw6.AddPair(P1, P2).Result()
 		gt.Exp(e0, e0, c)
 		if !e0.Equal(e1) {
@@ -103,13 +103,13 @@ func TestPairingBilinearity(t *testing.T) {
 		a, b := big.NewInt(17), big.NewInt(117)
 		c := new(big.Int).Mul(a, b)
 		// LHS
-		G1, G2 := bw6.g.G1One(), bw6.g.G2One()
-		bw6.g.MulScalarG1(G1, G1, c)
+		G1, G2 := bw6.g1.One(), bw6.g2.One()
+		bw6.g1.MulScalar(G1, G1, c)
 		bw6.AddPair(G1, G2)
 		// RHS
-		P1, P2 := bw6.g.G1One(), bw6.g.G2One()
-		bw6.g.MulScalarG1(P1, P1, a)
-		bw6.g.MulScalarG2(P2, P2, b)
+		P1, P2 := bw6.g1.One(), bw6.g2.One()
+		bw6.g1.MulScalar(P1, P1, a)
+		bw6.g2.MulScalar(P2, P2, b)
 		bw6.AddPairInv(P1, P2)
 		// should be one
 		if !bw6.Check() {
@@ -128,9 +128,9 @@ func TestPairingMulti(t *testing.T) {
 	for i := 0; i < numOfPair; i++ {
 		// (ai1 * G1, ai2 * G2)
 		a1, a2 := randScalar(q), randScalar(q)
-		P1, P2 := bw6.g.G1One(), bw6.g.G2One()
-		bw6.g.MulScalarG1(P1, P1, a1)
-		bw6.g.MulScalarG2(P2, P2, a2)
+		P1, P2 := bw6.g1.One(), bw6.g2.One()
+		bw6.g1.MulScalar(P1, P1, a1)
+		bw6.g2.MulScalar(P2, P2, a2)
 		bw6.AddPair(P1, P2)
 		// accumulate targetExp
 		// t += (ai1 * ai2)
@@ -139,8 +139,8 @@ func TestPairingMulti(t *testing.T) {
 	}
 	// LHS
 	// e(t * G1, G2)
-	T1, T2 := bw6.g.G1One(), bw6.g.G2One()
-	bw6.g.MulScalarG1(T1, T1, targetExp)
+	T1, T2 := bw6.g1.One(), bw6.g2.One()
+	bw6.g1.MulScalar(T1, T1, targetExp)
 	bw6.AddPairInv(T1, T2)
 	if !bw6.Check() {
 		t.Fatal("fail multi pairing")
@@ -161,7 +161,7 @@ func BenchmarkPairing(t *testing.B) {
 	t.ResetTimer()
 	for i := 0; i < t.N; i++ {
 		bw6 := NewEngine()
-		bw6.AddPair(bw6.g.G1One(), bw6.g.G2One())
+		bw6.AddPair(bw6.g1.One(), bw6.g2.One())
 		bw6.calculate()
 	}
 }
@@ -0,0 +1,313 @@
+package bw6
+
+import (
+	"errors"
+)
+
+// polyMulThreshold is the smallest operand size that polynomial multiplication switches to FFT.
+var polyMulThreshold = 64
+
+// Polynomial is dense univariate polynomial over the scalar field.
+// Coefficients are in ascending order of degree such that p[i] is coefficient of X^i.
+type Polynomial []*Fr
+
+// NewPolynomial returns a new polynomial with copy of given coefficients.
+func NewPolynomial(coeffs []*Fr) Polynomial {
+	p := make(Polynomial, len(coeffs))
+	for i := 0; i < len(coeffs); i++ {
+		p[i] = new(Fr).Set(coeffs[i])
+	}
+	return p
+}
+
+// VanishingPolynomial returns the polynomial which is the product of (X - x_i) for given points.
+func VanishingPolynomial(xs []*Fr) Polynomial {
+	z := Polynomial{new(Fr).One()}
+	for i := 0; i < len(xs); i++ {
+		z = z.mulLinear(xs[i])
+	}
+	return z
+}
+
+// DomainVanishingPolynomial returns X^n - 1 where n is size of the domain.
+func DomainVanishingPolynomial(d *Domain) Polynomial {
+	z := newZeroPolynomial(d.n + 1)
+	z[0].One()
+	negFR(z[0], z[0])
+	z[d.n].One()
+	return z
+}
+
+// Interpolate returns the unique polynomial with degree less than number of points
+// that passes through given points with Lagrange interpolation.
+func Interpolate(xs, ys []*Fr) (Polynomial, error) {
+	n := len(xs)
+	if n != len(ys) {
+		return nil, errors.New("number of x and y coordinates must be equal")
+	}
+	if n == 0 {
+		return Polynomial{}, nil
+	}
+	// z(X) = prod (X - x_i)
+	// L_i(X) = z(X) / (X - x_i) / z'(x_i)
+	z := VanishingPolynomial(xs)
+	res := newZeroPolynomial(n)
+	t := new(Fr)
+	for i := 0; i < n; i++ {
+		li, _ := z.DivideByLinear(xs[i])
+		// denominator is equal to L_i(x_i) before normalization
+		d := li.Evaluate(xs[i])
+		if d.IsZero() {
+			return nil, errors.New("x coordinates must be distinct")
+		}
+		inverseFR(d, d)
+		mulFR(d, d, ys[i])
+		for j := 0; j < n; j++ {
+			mulFR(t, li[j], d)
+			addFR(res[j], res[j], t)
+		}
+	}
+	return res.normalize(), nil
+}
+
+// Clone returns a deep copy of the polynomial.
+func (p Polynomial) Clone() Polynomial {
+	return NewPolynomial(p)
+}
+
+// Degree returns degree of the polynomial. Degree of zero polynomial is -1.
+func (p Polynomial) Degree() int {
+	for i := len(p) - 1; i >= 0; i-- {
+		if !p[i].IsZero() {
+			return i
+		}
+	}
+	return -1
+}
+
+// IsZero returns true if all coefficients of the polynomial are zero.
+func (p Polynomial) IsZero() bool {
+	return p.Degree() == -1
+}
+
+// Equal returns true if two polynomials are equal ignoring leading zero coefficients.
+func (p Polynomial) Equal(p2 Polynomial) bool {
+	d := p.Degree()
+	if d != p2.Degree() {
+		return false
+	}
+	for i := 0; i <= d; i++ {
+		if !p[i].Equal(p2[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Evaluate returns the value of the polynomial at given point.
+func (p Polynomial) Evaluate(x *Fr) *Fr {
+	acc := new(Fr)
+	for i := len(p) - 1; i >= 0; i-- {
+		mulFR(acc, acc, x)
+		addFR(acc, acc, p[i])
+	}
+	return acc
+}
+
+// EvaluateMulti returns values of the polynomial at given points.
+func (p Polynomial) EvaluateMulti(xs []*Fr) []*Fr {
+	out := make([]*Fr, len(xs))
+	parallel(len(xs), workerCount(len(xs)*len(p)), func(start, end int) {
+		for i := start; i < end; i++ {
+			out[i] = p.Evaluate(xs[i])
+		}
+	})
+	return out
+}
+
+// Add returns a new polynomial which is sum of two polynomials.
+func (p Polynomial) Add(p2 Polynomial) Polynomial {
+	a, b := p, p2
+	if len(a) < len(b) {
+		a, b = b, a
+	}
+	r := a.Clone()
+	for i := 0; i < len(b); i++ {
+		addFR(r[i], r[i], b[i])
+	}
+	return r.normalize()
+}
+
+// Sub returns a new polynomial which is subtraction of the second polynomial from the first.
+func (p Polynomial) Sub(p2 Polynomial) Polynomial {
+	n := len(p)
+	if len(p2) > n {
+		n = len(p2)
+	}
+	r := newZeroPolynomial(n)
+	for i := 0; i < len(p); i++ {
+		r[i].Set(p[i])
+	}
+	for i := 0; i < len(p2); i++ {
+		subFR(r[i], r[i], p2[i])
+	}
+	return r.normalize()
+}
+
+// Neg returns a new polynomial which is negation of the polynomial.
+func (p Polynomial) Neg() Polynomial {
+	r := newZeroPolynomial(len(p))
+	for i := 0; i < len(p); i++ {
+		negFR(r[i], p[i])
+	}
+	return r
+}
+
+// MulScalar returns a new polynomial which is the polynomial multiplied by a constant.
+func (p Polynomial) MulScalar(c *Fr) Polynomial {
+	r := newZeroPolynomial(len(p))
+	for i := 0; i < len(p); i++ {
+		mulFR(r[i], p[i], c)
+	}
+	return r.normalize()
+}
+
+// Mul returns a new polynomial which is product of two polynomials.
+// Large operands are multiplied with FFT over a domain which covers the product.
+func (p Polynomial) Mul(p2 Polynomial) Polynomial {
+	a, b := p.normalize(), p2.normalize()
+	if len(a) == 0 || len(b) == 0 {
+		return Polynomial{}
+	}
+	if len(a) < polyMulThreshold || len(b) < polyMulThreshold {
+		return a.mulNaive(b)
+	}
+	n := len(a) + len(b) - 1
+	d, err := NewDomain(n)
+	if err != nil {
+		return a.mulNaive(b)
+	}
+	u, v := a.padded(d.n), b.padded(d.n)
+	_ = d.FFT(u)
+	_ = d.FFT(v)
+	for i := 0; i < d.n; i++ {
+		mulFR(u[i], u[i], v[i])
+	}
+	_ = d.InverseFFT(u)
+	return Polynomial(u[:n]).normalize()
+}
+
+// Div returns quotient and remainder of the long division of the polynomial by the divisor.
+func (p Polynomial) Div(divisor Polynomial) (Polynomial, Polynomial, error) {
+	db := divisor.Degree()
+	if db == -1 {
+		return nil, nil, errors.New("division by zero polynomial")
+	}
+	rem := p.Clone().normalize()
+	da := len(rem) - 1
+	if da < db {
+		return Polynomial{}, rem, nil
+	}
+	quo := newZeroPolynomial(da - db + 1)
+	lcInv := new(Fr).Inverse(divisor[db])
+	t := new(Fr)
+	for i := da - db; i >= 0; i-- {
+		c := quo[i]
+		mulFR(c, rem[i+db], lcInv)
+		if c.IsZero() {
+			continue
+		}
+		for j := 0; j <= db; j++ {
+			mulFR(t, c, divisor[j])
+			subFR(rem[i+j], rem[i+j], t)
+		}
+	}
+	return quo.normalize(), rem[:db].normalize(), nil
+}
+
+// DivideByLinear divides the polynomial by (X - z) and returns the quotient
+// and the remainder which is equal to evaluation of the polynomial at z.
+func (p Polynomial) DivideByLinear(z *Fr) (Polynomial, *Fr) {
+	n := len(p)
+	if n == 0 {
+		return Polynomial{}, new(Fr)
+	}
+	quo := newZeroPolynomial(n - 1)
+	acc := new(Fr)
+	for i := n - 1; i >= 1; i-- {
+		mulFR(acc, acc, z)
+		addFR(acc, acc, p[i])
+		quo[i-1].Set(acc)
+	}
+	mulFR(acc, acc, z)
+	addFR(acc, acc, p[0])
+	return quo, acc
+}
+
+// DivideByVanishing divides the polynomial by X^n - 1 where n is size of the domain.
+// It returns an error if the polynomial is not divisible.
+func (p Polynomial) DivideByVanishing(d *Domain) (Polynomial, error) {
+	rem := p.Clone().normalize()
+	if len(rem) <= d.n {
+		if len(rem) == 0 {
+			return Polynomial{}, nil
+		}
+		return nil, errors.New("polynomial is not divisible by vanishing polynomial")
+	}
+	// X^n = 1 over the domain, so a_i * X^i reduces to a_i * X^(i-n)
+	quo := newZeroPolynomial(len(rem) - d.n)
+	for i := len(rem) - 1; i >= d.n; i-- {
+		quo[i-d.n].Set(rem[i])
+		addFR(rem[i-d.n], rem[i-d.n], rem[i])
+	}
+	if !Polynomial(rem[:d.n]).IsZero() {
+		return nil, errors.New("polynomial is not divisible by vanishing polynomial")
+	}
+	return quo, nil
+}
+
+func newZeroPolynomial(n int) Polynomial {
+	p := make(Polynomial, n)
+	for i := 0; i < n; i++ {
+		p[i] = new(Fr)
+	}
+	return p
+}
+
+// normalize trims leading zero coefficients
+func (p Polynomial) normalize() Polynomial {
+	return p[:p.Degree()+1]
+}
+
+// padded returns copy of coefficients extended with zeros up to given size
+func (p Polynomial) padded(n int) []*Fr {
+	r := newZeroPolynomial(n)
+	for i := 0; i < len(p); i++ {
+		r[i].Set(p[i])
+	}
+	return r
+}
+
+func (p Polynomial) mulNaive(p2 Polynomial) Polynomial {
+	r := newZeroPolynomial(len(p) + len(p2) - 1)
+	t := new(Fr)
+	for i := 0; i < len(p); i++ {
+		for j := 0; j < len(p2); j++ {
+			mulFR(t, p[i], p2[j])
+			addFR(r[i+j], r[i+j], t)
+		}
+	}
+	return r.normalize()
+}
+
+// mulLinear returns p(X) * (X - z)
+func (p Polynomial) mulLinear(z *Fr) Polynomial {
+	r := newZeroPolynomial(len(p) + 1)
+	t := new(Fr)
+	for i := 0; i < len(p); i++ {
+		addFR(r[i+1], r[i+1], p[i])
+		mulFR(t, p[i], z)
+		subFR(r[i], r[i], t)
+	}
+	return r
+}
@@ -0,0 +1,187 @@
+package bw6
+
+import (
+	"crypto/rand"
+	"testing"
+)
+
+func randPolynomial(n int) Polynomial {
+	p := Polynomial(randFrVector(n))
+	if n > 0 && p[n-1].IsZero() {
+		p[n-1].One()
+	}
+	return p
+}
+
+func TestPolynomialArithmetic(t *testing.T) {
+	for i := 0; i < fuz; i++ {
+		a, b := randPolynomial(10), randPolynomial(7)
+		x, _ := new(Fr).Rand(rand.Reader)
+		ax, bx := a.Evaluate(x), b.Evaluate(x)
+		expected := new(Fr).Add(ax, bx)
+		if !a.Add(b).Evaluate(x).Equal(expected) {
+			t.Fatal("(a + b)(x) == a(x) + b(x)")
+		}
+		expected.Sub(ax, bx)
+		if !a.Sub(b).Evaluate(x).Equal(expected) {
+			t.Fatal("(a - b)(x) == a(x) - b(x)")
+		}
+		expected.Mul(ax, bx)
+		if !a.Mul(b).Evaluate(x).Equal(expected) {
+			t.Fatal("(a * b)(x) == a(x) * b(x)")
+		}
+		expected.Mul(ax, x)
+		if !a.MulScalar(x).Evaluate(x).Equal(expected) {
+			t.Fatal("(a * c)(x) == a(x) * c")
+		}
+		if !a.Add(a.Neg()).IsZero() {
+			t.Fatal("a - a == 0")
+		}
+		if a.Mul(b).Degree() != 15 {
+			t.Fatal("deg(a * b) == deg(a) + deg(b)")
+		}
+		if !a.Sub(a).Equal(Polynomial{}) {
+			t.Fatal("a - a == 0")
+		}
+	}
+}
+
+func TestPolynomialMulFFT(t *testing.T) {
+	threshold := polyMulThreshold
+	defer func() { polyMulThreshold = threshold }()
+	for _, sizes := range [][2]int{{64, 64}, {100, 77}, {300, 1}, {129, 128}} {
+		a, b := randPolynomial(sizes[0]), randPolynomial(sizes[1])
+		polyMulThreshold = 1
+		c0 := a.Mul(b)
+		polyMulThreshold = 1 << 20
+		c1 := a.Mul(b)
+		if !c0.Equal(c1) {
+			t.Fatal("fft based multiplication failed", sizes)
+		}
+	}
+}
+
+func TestPolynomialDivision(t *testing.T) {
+	for i := 0; i < fuz; i++ {
+		a, b := randPolynomial(20), randPolynomial(6)
+		quo, rem, err := a.Div(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rem.Degree() >= b.Degree() {
+			t.Fatal("deg(r) < deg(b)")
+		}
+		if !quo.Mul(b).Add(rem).Equal(a) {
+			t.Fatal("a == q * b + r")
+		}
+		quo, rem, err = b.Div(a)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !quo.IsZero() || !rem.Equal(b) {
+			t.Fatal("b / a == 0 when deg(b) < deg(a)")
+		}
+	}
+	if _, _, err := randPolynomial(4).Div(Polynomial{new(Fr)}); err == nil {
+		t.Fatal("division by zero must be rejected")
+	}
+}
+
+func TestPolynomialDivideByLinear(t *testing.T) {
+	for i := 0; i < fuz; i++ {
+		a := randPolynomial(16)
+		z, _ := new(Fr).Rand(rand.Reader)
+		quo, rem := a.DivideByLinear(z)
+		if !rem.Equal(a.Evaluate(z)) {
+			t.Fatal("a mod (X - z) == a(z)")
+		}
+		linear := Polynomial{new(Fr).Neg(z), new(Fr).One()}
+		if !quo.Mul(linear).Add(Polynomial{rem}).Equal(a) {
+			t.Fatal("a == q * (X - z) + a(z)")
+		}
+	}
+}
+
+func TestPolynomialInterpolation(t *testing.T) {
+	for _, n := range []int{1, 2, 5, 16} {
+		xs, ys := randFrVector(n), randFrVector(n)
+		p, err := Interpolate(xs, ys)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if p.Degree() >= n {
+			t.Fatal("deg(p) < n")
+		}
+		evals := p.EvaluateMulti(xs)
+		for i := 0; i < n; i++ {
+			if !evals[i].Equal(ys[i]) {
+				t.Fatal("p(x_i) == y_i")
+			}
+		}
+	}
+	xs, ys := randFrVector(3), randFrVector(3)
+	xs[2].Set(xs[0])
+	if _, err := Interpolate(xs, ys); err == nil {
+		t.Fatal("duplicate x coordinates must be rejected")
+	}
+	if _, err := Interpolate(xs, ys[1:]); err == nil {
+		t.Fatal("length mismatch must be rejected")
+	}
+}
+
+func TestPolynomialInterpolationOverDomain(t *testing.T) {
+	d, _ := NewDomain(16)
+	coeffs := randPolynomial(16)
+	evals := copyFrVector(coeffs)
+	_ = d.FFT(evals)
+	xs := make([]*Fr, d.Size())
+	for i := 0; i < d.Size(); i++ {
+		xs[i] = d.Element(i)
+	}
+	p, err := Interpolate(xs, evals)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !p.Equal(coeffs) {
+		t.Fatal("lagrange interpolation must agree with inverse fft")
+	}
+}
+
+func TestPolynomialVanishing(t *testing.T) {
+	xs := randFrVector(8)
+	z := VanishingPolynomial(xs)
+	if z.Degree() != 8 {
+		t.Fatal("deg(z) == number of points")
+	}
+	for _, e := range z.EvaluateMulti(xs) {
+		if !e.IsZero() {
+			t.Fatal("z(x_i) == 0")
+		}
+	}
+	d, _ := NewDomain(8)
+	zd := DomainVanishingPolynomial(d)
+	for i := 0; i < d.Size(); i++ {
+		if !zd.Evaluate(d.Element(i)).IsZero() {
+			t.Fatal("z_H(w^i) == 0")
+		}
+	}
+	a := randPolynomial(13)
+	quo, err := a.Mul(zd).DivideByVanishing(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !quo.Equal(a) {
+		t.Fatal("(a * z_H) / z_H == a")
+	}
+	if _, err := a.DivideByVanishing(d); err == nil {
+		t.Fatal("non divisible polynomial must be rejected")
+	}
+}
+
+func BenchmarkPolynomialMul(t *testing.B) {
+	a, b := randPolynomial(1<<12), randPolynomial(1<<12)
+	t.ResetTimer()
+	for i := 0; i < t.N; i++ {
+		a.Mul(b)
+	}
+}
@@ -0,0 +1,63 @@
+//! Prints arkworks serialization vectors used in arkworks_test.go.
+//! Run with `cargo run --release` in this directory and paste the output into arkworks_test.go.
+
+use ark_bw6_761::{Fr, G1Affine, G2Affine, BW6_761};
+use ark_ec::{pairing::Pairing, AffineRepr};
+use ark_ff::PrimeField;
+use ark_serialize::CanonicalSerialize;
+
+fn hex<T: CanonicalSerialize>(t: &T, compressed: bool) -> String {
+    let mut buf = Vec::new();
+    if compressed {
+        t.serialize_compressed(&mut buf).unwrap();
+    } else {
+        t.serialize_uncompressed(&mut buf).unwrap();
+    }
+    buf.iter().map(|b| format!("{:02x}", b)).collect()
+}
+
+fn point<P: AffineRepr>(name: &str, g: P) {
+    println!("var {} = []struct {{", name);
+    println!("\tneg          bool\n\tcompressed   []byte\n\tuncompressed []byte\n}}{{");
+    for (neg, p) in [(false, g), (true, (-g.into_group()).into())] {
+        let uncompressed = hex(&p, false);
+        let (x, y) = uncompressed.split_at(uncompressed.len() / 2);
+        println!("\t{{\n\t\t{},", neg);
+        println!("\t\tfromHex(0,\n\t\t\t\"{}\",\n\t\t),", hex(&p, true));
+        println!("\t\tfromHex(0,\n\t\t\t\"{}\",\n\t\t\t\"{}\",\n\t\t),\n\t}},", x, y);
+    }
+    println!("}}\n");
+}
+
+fn main() {
+    point("arkworksG1Vectors", G1Affine::generator());
+    point("arkworksG2Vectors", G2Affine::generator());
+
+    let gt = BW6_761::pairing(G1Affine::generator(), G2Affine::generator()).0;
+    let encoded = hex(&gt, false);
+    println!("var arkworksGTVector = fromHex(0,");
+    for i in 0..6 {
+        println!("\t\"{}\",", &encoded[i * 192..(i + 1) * 192]);
+    }
+    println!(")\n");
+
+    let values = [
+        "01",
+        "01ae3a4617c510eac63b05c06ca1493b1a22d9f300f5138f1ef3622fba094800170b5d44300000008508c00000000000",
+        "0123456789abcdeffedcba987654321000112233445566778899aabbccddeeff0123456789abcdef0000000000000001",
+    ];
+    println!("var arkworksFrVectors = []struct {{\n\tvalue   string\n\tencoded []byte\n}}{{");
+    for v in values {
+        let bytes: Vec<u8> = (0..v.len())
+            .step_by(2)
+            .map(|i| u8::from_str_radix(&v[i..i + 2], 16).unwrap())
+            .collect();
+        let e = Fr::from_be_bytes_mod_order(&bytes);
+        println!(
+            "\t{{\"0x{}\", fromHex(0, \"{}\")}},",
+            v.trim_start_matches('0'),
+            hex(&e, false)
+        );
+    }
+    println!("}}");
+}
@@ -4,6 +4,19 @@ import (
 	"math/big"
 )
 
+// bigToWindows splits a non negative integer into unsigned digits of given bit size
+// starting from the least significant digit.
+func bigToWindows(e *big.Int, size uint) []int {
+	n := (e.BitLen() + int(size) - 1) / int(size)
+	windows := make([]int, n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < int(size); j++ {
+			windows[i] |= int(e.Bit(i*int(size)+j)) << uint(j)
+		}
+	}
+	return windows
+}
+
 func bigFromHex(hex string) *big.Int {
 	if len(hex) > 1 && hex[:2] == "0x" {
 		hex = hex[2:]
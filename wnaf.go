@@ -2,6 +2,7 @@ package bw6
 
 import (
 	"math/big"
+	"math/bits"
 )
 
 type nafNumber []int
@@ -15,7 +16,8 @@ func (n nafNumber) neg() {
 var bigZero = big.NewInt(0)
 var bigOne = big.NewInt(1)
 
-// caution: does not cover negative case
+// bigToWNAF returns window non adjacent form of the scalar.
+// Digits of a negative scalar are the negated digits of its absolute value.
 func bigToWNAF(e *big.Int, w uint) nafNumber {
 	naf := nafNumber{}
 	if w == 0 {
@@ -38,6 +40,45 @@ func bigToWNAF(e *big.Int, w uint) nafNumber {
 		}
 		ee.Rsh(ee, 1)
 	}
+	if e.Sign() < 0 {
+		naf.neg()
+	}
+	return naf
+}
+
+// wnaf returns window non adjacent form of an element in non Montgomery form
+// with the same digits as bigToWNAF.
+func (e *Fr) wnaf(w uint) nafNumber {
+	naf := make(nafNumber, 0, frBitSize+1)
+	if w == 0 {
+		return naf
+	}
+	windowSize := uint64(1) << (w + 1)
+	halfSize := windowSize >> 1
+	k := *e
+	for !k.IsZero() {
+		if k[0]&1 == 1 {
+			d := k[0] & (windowSize - 1)
+			if d >= halfSize {
+				// k = k - (d - 2^(w + 1))
+				var carry uint64
+				k[0], carry = bits.Add64(k[0], windowSize-d, 0)
+				for i := 1; i < frNumberOfLimbs; i++ {
+					k[i], carry = bits.Add64(k[i], 0, carry)
+				}
+				naf = append(naf, int(d)-int(windowSize))
+			} else {
+				k[0] -= d
+				naf = append(naf, int(d))
+			}
+		} else {
+			naf = append(naf, 0)
+		}
+		for i := 0; i < frNumberOfLimbs-1; i++ {
+			k[i] = k[i]>>1 | k[i+1]<<63
+		}
+		k[frNumberOfLimbs-1] >>= 1
+	}
 	return naf
 }
 
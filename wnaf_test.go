@@ -8,6 +8,24 @@ import (
 
 var maxWindowSize uint = 9
 
+func TestFrWNAF(t *testing.T) {
+	var w uint
+	for w = 1; w <= maxWindowSize; w++ {
+		for i := 0; i < fuz; i++ {
+			e := randScalar(q)
+			n0, n1 := bigToWNAF(e, w), new(Fr).setBig(e).wnaf(w)
+			if len(n0) != len(n1) {
+				t.Fatal("wnaf conversion failed")
+			}
+			for j := range n0 {
+				if n0[j] != n1[j] {
+					t.Fatal("wnaf conversion failed")
+				}
+			}
+		}
+	}
+}
+
 func TestWNAF(t *testing.T) {
 	var w uint
 	for w = 1; w <= maxWindowSize; w++ {
@@ -21,6 +39,12 @@ func TestWNAF(t *testing.T) {
 			if e0.Cmp(e1) != 0 {
 				t.Fatal("wnaf conversion failed")
 			}
+			e0.Neg(e0)
+			n0 = bigToWNAF(e0, w)
+			e1 = bigFromWNAF(n0)
+			if e0.Cmp(e1) != 0 {
+				t.Fatal("wnaf conversion of negative value failed")
+			}
 		}
 	}
 }